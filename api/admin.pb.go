@@ -0,0 +1,3899 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        (unknown)
+// source: api/admin.proto
+
+// Package onos.pci.admin defines the onos-pci administration API, which complements the onos.pci API
+
+package api
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ConflictType is the type of a PCI conflict
+type ConflictType int32
+
+const (
+	// ANY_CONFLICT matches both collisions and confusions
+	ConflictType_ANY_CONFLICT ConflictType = 0
+	// COLLISION is a serving cell sharing its PCI with one of its neighbors
+	ConflictType_COLLISION ConflictType = 1
+	// CONFUSION is two neighbors of the same cell sharing a PCI
+	ConflictType_CONFUSION ConflictType = 2
+)
+
+// Enum value maps for ConflictType.
+var (
+	ConflictType_name = map[int32]string{
+		0: "ANY_CONFLICT",
+		1: "COLLISION",
+		2: "CONFUSION",
+	}
+	ConflictType_value = map[string]int32{
+		"ANY_CONFLICT": 0,
+		"COLLISION":    1,
+		"CONFUSION":    2,
+	}
+)
+
+func (x ConflictType) Enum() *ConflictType {
+	p := new(ConflictType)
+	*p = x
+	return p
+}
+
+func (x ConflictType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_admin_proto_enumTypes[0].Descriptor()
+}
+
+func (ConflictType) Type() protoreflect.EnumType {
+	return &file_api_admin_proto_enumTypes[0]
+}
+
+func (x ConflictType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictType.Descriptor instead.
+func (ConflictType) EnumDescriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{0}
+}
+
+// ReservationScope is the scope of a reserved PCI list
+type ReservationScope int32
+
+const (
+	// GLOBAL reserved PCIs are excluded for all cells
+	ReservationScope_GLOBAL ReservationScope = 0
+	// PLMN reserved PCIs are excluded for the cells of a PLMN
+	ReservationScope_PLMN ReservationScope = 1
+	// CLUSTER reserved PCIs are excluded for the cells of a geographic cluster
+	ReservationScope_CLUSTER ReservationScope = 2
+)
+
+// Enum value maps for ReservationScope.
+var (
+	ReservationScope_name = map[int32]string{
+		0: "GLOBAL",
+		1: "PLMN",
+		2: "CLUSTER",
+	}
+	ReservationScope_value = map[string]int32{
+		"GLOBAL":  0,
+		"PLMN":    1,
+		"CLUSTER": 2,
+	}
+)
+
+func (x ReservationScope) Enum() *ReservationScope {
+	p := new(ReservationScope)
+	*p = x
+	return p
+}
+
+func (x ReservationScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReservationScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_admin_proto_enumTypes[1].Descriptor()
+}
+
+func (ReservationScope) Type() protoreflect.EnumType {
+	return &file_api_admin_proto_enumTypes[1]
+}
+
+func (x ReservationScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReservationScope.Descriptor instead.
+func (ReservationScope) EnumDescriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{1}
+}
+
+// ProposalStatus is the status of a PCI change proposal
+type ProposalStatus int32
+
+const (
+	ProposalStatus_PENDING  ProposalStatus = 0
+	ProposalStatus_APPROVED ProposalStatus = 1
+	ProposalStatus_REJECTED ProposalStatus = 2
+	// EXPIRED proposals were not decided in time
+	ProposalStatus_EXPIRED ProposalStatus = 3
+	// SUPERSEDED proposals were replaced by a newer proposal for the same cell
+	ProposalStatus_SUPERSEDED ProposalStatus = 4
+)
+
+// Enum value maps for ProposalStatus.
+var (
+	ProposalStatus_name = map[int32]string{
+		0: "PENDING",
+		1: "APPROVED",
+		2: "REJECTED",
+		3: "EXPIRED",
+		4: "SUPERSEDED",
+	}
+	ProposalStatus_value = map[string]int32{
+		"PENDING":    0,
+		"APPROVED":   1,
+		"REJECTED":   2,
+		"EXPIRED":    3,
+		"SUPERSEDED": 4,
+	}
+)
+
+func (x ProposalStatus) Enum() *ProposalStatus {
+	p := new(ProposalStatus)
+	*p = x
+	return p
+}
+
+func (x ProposalStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProposalStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_admin_proto_enumTypes[2].Descriptor()
+}
+
+func (ProposalStatus) Type() protoreflect.EnumType {
+	return &file_api_admin_proto_enumTypes[2]
+}
+
+func (x ProposalStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProposalStatus.Descriptor instead.
+func (ProposalStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{2}
+}
+
+// PciChangeTrigger is what triggered a PCI change
+type PciChangeTrigger int32
+
+const (
+	// CONFLICT_TRIGGER changes resolve a collision or confusion detected when an indication message arrived
+	PciChangeTrigger_CONFLICT_TRIGGER PciChangeTrigger = 0
+	// OPTIMIZATION_TRIGGER changes are made by the optimizer
+	PciChangeTrigger_OPTIMIZATION_TRIGGER PciChangeTrigger = 1
+	// MANUAL_TRIGGER changes are set by the operator with SetPci
+	PciChangeTrigger_MANUAL_TRIGGER PciChangeTrigger = 2
+	// PROPOSAL_TRIGGER changes are proposals approved by the operator
+	PciChangeTrigger_PROPOSAL_TRIGGER PciChangeTrigger = 3
+	// ROLLBACK_TRIGGER changes set the PCI back to the previous PCI after the RC control message of a change finally
+	// failed; no RC control message is sent for them
+	PciChangeTrigger_ROLLBACK_TRIGGER PciChangeTrigger = 4
+)
+
+// Enum value maps for PciChangeTrigger.
+var (
+	PciChangeTrigger_name = map[int32]string{
+		0: "CONFLICT_TRIGGER",
+		1: "OPTIMIZATION_TRIGGER",
+		2: "MANUAL_TRIGGER",
+		3: "PROPOSAL_TRIGGER",
+		4: "ROLLBACK_TRIGGER",
+	}
+	PciChangeTrigger_value = map[string]int32{
+		"CONFLICT_TRIGGER":     0,
+		"OPTIMIZATION_TRIGGER": 1,
+		"MANUAL_TRIGGER":       2,
+		"PROPOSAL_TRIGGER":     3,
+		"ROLLBACK_TRIGGER":     4,
+	}
+)
+
+func (x PciChangeTrigger) Enum() *PciChangeTrigger {
+	p := new(PciChangeTrigger)
+	*p = x
+	return p
+}
+
+func (x PciChangeTrigger) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PciChangeTrigger) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_admin_proto_enumTypes[3].Descriptor()
+}
+
+func (PciChangeTrigger) Type() protoreflect.EnumType {
+	return &file_api_admin_proto_enumTypes[3]
+}
+
+func (x PciChangeTrigger) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PciChangeTrigger.Descriptor instead.
+func (PciChangeTrigger) EnumDescriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{3}
+}
+
+// PciChangeActor is who made a PCI change
+type PciChangeActor int32
+
+const (
+	PciChangeActor_CONTROLLER PciChangeActor = 0
+	PciChangeActor_OPERATOR   PciChangeActor = 1
+)
+
+// Enum value maps for PciChangeActor.
+var (
+	PciChangeActor_name = map[int32]string{
+		0: "CONTROLLER",
+		1: "OPERATOR",
+	}
+	PciChangeActor_value = map[string]int32{
+		"CONTROLLER": 0,
+		"OPERATOR":   1,
+	}
+)
+
+func (x PciChangeActor) Enum() *PciChangeActor {
+	p := new(PciChangeActor)
+	*p = x
+	return p
+}
+
+func (x PciChangeActor) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PciChangeActor) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_admin_proto_enumTypes[4].Descriptor()
+}
+
+func (PciChangeActor) Type() protoreflect.EnumType {
+	return &file_api_admin_proto_enumTypes[4]
+}
+
+func (x PciChangeActor) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PciChangeActor.Descriptor instead.
+func (PciChangeActor) EnumDescriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{4}
+}
+
+// ControlFailureType is the class of failure of an RC control message
+type ControlFailureType int32
+
+const (
+	ControlFailureType_NO_FAILURE ControlFailureType = 0
+	// BUILD_FAILURE control messages could not be encoded
+	ControlFailureType_BUILD_FAILURE ControlFailureType = 1
+	// E2T_FAILURE control messages could not be delivered through E2T
+	ControlFailureType_E2T_FAILURE ControlFailureType = 2
+	// RIC_CONTROL_FAILURE control messages were rejected by the E2 node with a cause
+	ControlFailureType_RIC_CONTROL_FAILURE ControlFailureType = 3
+)
+
+// Enum value maps for ControlFailureType.
+var (
+	ControlFailureType_name = map[int32]string{
+		0: "NO_FAILURE",
+		1: "BUILD_FAILURE",
+		2: "E2T_FAILURE",
+		3: "RIC_CONTROL_FAILURE",
+	}
+	ControlFailureType_value = map[string]int32{
+		"NO_FAILURE":          0,
+		"BUILD_FAILURE":       1,
+		"E2T_FAILURE":         2,
+		"RIC_CONTROL_FAILURE": 3,
+	}
+)
+
+func (x ControlFailureType) Enum() *ControlFailureType {
+	p := new(ControlFailureType)
+	*p = x
+	return p
+}
+
+func (x ControlFailureType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ControlFailureType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_admin_proto_enumTypes[5].Descriptor()
+}
+
+func (ControlFailureType) Type() protoreflect.EnumType {
+	return &file_api_admin_proto_enumTypes[5]
+}
+
+func (x ControlFailureType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ControlFailureType.Descriptor instead.
+func (ControlFailureType) EnumDescriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{5}
+}
+
+// PciChangeVerification is whether the next indication message of the cell reported the new PCI
+type PciChangeVerification int32
+
+const (
+	// NOT_VERIFIED changes wait for the control outcome or the next indication message, or their control message failed
+	PciChangeVerification_NOT_VERIFIED PciChangeVerification = 0
+	// CONFIRMED changes were reported by the next indication message
+	PciChangeVerification_CONFIRMED PciChangeVerification = 1
+	// REVERTED_BY_NODE changes were not reported by the next indication message, which had another PCI
+	PciChangeVerification_REVERTED_BY_NODE PciChangeVerification = 2
+	// TIMED_OUT changes were not followed by an indication message in time
+	PciChangeVerification_TIMED_OUT PciChangeVerification = 3
+)
+
+// Enum value maps for PciChangeVerification.
+var (
+	PciChangeVerification_name = map[int32]string{
+		0: "NOT_VERIFIED",
+		1: "CONFIRMED",
+		2: "REVERTED_BY_NODE",
+		3: "TIMED_OUT",
+	}
+	PciChangeVerification_value = map[string]int32{
+		"NOT_VERIFIED":     0,
+		"CONFIRMED":        1,
+		"REVERTED_BY_NODE": 2,
+		"TIMED_OUT":        3,
+	}
+)
+
+func (x PciChangeVerification) Enum() *PciChangeVerification {
+	p := new(PciChangeVerification)
+	*p = x
+	return p
+}
+
+func (x PciChangeVerification) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PciChangeVerification) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_admin_proto_enumTypes[6].Descriptor()
+}
+
+func (PciChangeVerification) Type() protoreflect.EnumType {
+	return &file_api_admin_proto_enumTypes[6]
+}
+
+func (x PciChangeVerification) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PciChangeVerification.Descriptor instead.
+func (PciChangeVerification) EnumDescriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{6}
+}
+
+// CellEventType is the type of a change of a cell
+type CellEventType int32
+
+const (
+	// CELL_ADDED cells are new, or already existing when the watch starts
+	CellEventType_CELL_ADDED CellEventType = 0
+	// CELL_PCI_CHANGED cells got a new PCI, set by onos-pci or reported by the E2 node
+	CellEventType_CELL_PCI_CHANGED CellEventType = 1
+	// CELL_UPDATED cells changed otherwise, e.g., they were locked or became stale
+	CellEventType_CELL_UPDATED CellEventType = 2
+	// CELL_REMOVED cells were removed, e.g., because their E2 node disconnected
+	CellEventType_CELL_REMOVED CellEventType = 3
+)
+
+// Enum value maps for CellEventType.
+var (
+	CellEventType_name = map[int32]string{
+		0: "CELL_ADDED",
+		1: "CELL_PCI_CHANGED",
+		2: "CELL_UPDATED",
+		3: "CELL_REMOVED",
+	}
+	CellEventType_value = map[string]int32{
+		"CELL_ADDED":       0,
+		"CELL_PCI_CHANGED": 1,
+		"CELL_UPDATED":     2,
+		"CELL_REMOVED":     3,
+	}
+)
+
+func (x CellEventType) Enum() *CellEventType {
+	p := new(CellEventType)
+	*p = x
+	return p
+}
+
+func (x CellEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CellEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_admin_proto_enumTypes[7].Descriptor()
+}
+
+func (CellEventType) Type() protoreflect.EnumType {
+	return &file_api_admin_proto_enumTypes[7]
+}
+
+func (x CellEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CellEventType.Descriptor instead.
+func (CellEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{7}
+}
+
+// ConflictEventType is the type of a change of a PCI conflict
+type ConflictEventType int32
+
+const (
+	// CONFLICT_DETECTED conflicts are new, or already existing when the watch starts
+	ConflictEventType_CONFLICT_DETECTED ConflictEventType = 0
+	// CONFLICT_RESOLVED conflicts no longer exist
+	ConflictEventType_CONFLICT_RESOLVED ConflictEventType = 1
+)
+
+// Enum value maps for ConflictEventType.
+var (
+	ConflictEventType_name = map[int32]string{
+		0: "CONFLICT_DETECTED",
+		1: "CONFLICT_RESOLVED",
+	}
+	ConflictEventType_value = map[string]int32{
+		"CONFLICT_DETECTED": 0,
+		"CONFLICT_RESOLVED": 1,
+	}
+)
+
+func (x ConflictEventType) Enum() *ConflictEventType {
+	p := new(ConflictEventType)
+	*p = x
+	return p
+}
+
+func (x ConflictEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConflictEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_admin_proto_enumTypes[8].Descriptor()
+}
+
+func (ConflictEventType) Type() protoreflect.EnumType {
+	return &file_api_admin_proto_enumTypes[8]
+}
+
+func (x ConflictEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConflictEventType.Descriptor instead.
+func (ConflictEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{8}
+}
+
+// Conflict is a PCI conflict between a pair of cells
+type Conflict struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       ConflictType `protobuf:"varint,1,opt,name=type,proto3,enum=onos.pci.admin.ConflictType" json:"type,omitempty"`
+	CellId     uint64       `protobuf:"varint,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	PeerCellId uint64       `protobuf:"varint,3,opt,name=peer_cell_id,json=peerCellId,proto3" json:"peer_cell_id,omitempty"`
+	// common_neighbor_id is the cell both conflicting cells are neighbors of; only set for confusions
+	CommonNeighborId uint64 `protobuf:"varint,4,opt,name=common_neighbor_id,json=commonNeighborId,proto3" json:"common_neighbor_id,omitempty"`
+	Pci              uint32 `protobuf:"varint,5,opt,name=pci,proto3" json:"pci,omitempty"`
+	Arfcn            uint32 `protobuf:"varint,6,opt,name=arfcn,proto3" json:"arfcn,omitempty"`
+}
+
+func (x *Conflict) Reset() {
+	*x = Conflict{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Conflict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conflict) ProtoMessage() {}
+
+func (x *Conflict) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conflict.ProtoReflect.Descriptor instead.
+func (*Conflict) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Conflict) GetType() ConflictType {
+	if x != nil {
+		return x.Type
+	}
+	return ConflictType_ANY_CONFLICT
+}
+
+func (x *Conflict) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *Conflict) GetPeerCellId() uint64 {
+	if x != nil {
+		return x.PeerCellId
+	}
+	return 0
+}
+
+func (x *Conflict) GetCommonNeighborId() uint64 {
+	if x != nil {
+		return x.CommonNeighborId
+	}
+	return 0
+}
+
+func (x *Conflict) GetPci() uint32 {
+	if x != nil {
+		return x.Pci
+	}
+	return 0
+}
+
+func (x *Conflict) GetArfcn() uint32 {
+	if x != nil {
+		return x.Arfcn
+	}
+	return 0
+}
+
+type ListConflictsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cell_id limits the conflicts to the ones a given cell is involved in; 0 means all cells
+	CellId uint64       `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	Type   ConflictType `protobuf:"varint,2,opt,name=type,proto3,enum=onos.pci.admin.ConflictType" json:"type,omitempty"`
+}
+
+func (x *ListConflictsRequest) Reset() {
+	*x = ListConflictsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConflictsRequest) ProtoMessage() {}
+
+func (x *ListConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConflictsRequest.ProtoReflect.Descriptor instead.
+func (*ListConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListConflictsRequest) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *ListConflictsRequest) GetType() ConflictType {
+	if x != nil {
+		return x.Type
+	}
+	return ConflictType_ANY_CONFLICT
+}
+
+type ListConflictsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Conflicts []*Conflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+}
+
+func (x *ListConflictsResponse) Reset() {
+	*x = ListConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConflictsResponse) ProtoMessage() {}
+
+func (x *ListConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConflictsResponse.ProtoReflect.Descriptor instead.
+func (*ListConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListConflictsResponse) GetConflicts() []*Conflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+// ReservedPcis is a list of PCIs that are never assigned to the cells in its scope
+type ReservedPcis struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scope ReservationScope `protobuf:"varint,1,opt,name=scope,proto3,enum=onos.pci.admin.ReservationScope" json:"scope,omitempty"`
+	// plmn_id is only set for PLMN scope
+	PlmnId uint32 `protobuf:"varint,2,opt,name=plmn_id,json=plmnId,proto3" json:"plmn_id,omitempty"`
+	// cluster is only set for CLUSTER scope
+	Cluster string   `protobuf:"bytes,3,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Pcis    []uint32 `protobuf:"varint,4,rep,packed,name=pcis,proto3" json:"pcis,omitempty"`
+}
+
+func (x *ReservedPcis) Reset() {
+	*x = ReservedPcis{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReservedPcis) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservedPcis) ProtoMessage() {}
+
+func (x *ReservedPcis) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservedPcis.ProtoReflect.Descriptor instead.
+func (*ReservedPcis) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ReservedPcis) GetScope() ReservationScope {
+	if x != nil {
+		return x.Scope
+	}
+	return ReservationScope_GLOBAL
+}
+
+func (x *ReservedPcis) GetPlmnId() uint32 {
+	if x != nil {
+		return x.PlmnId
+	}
+	return 0
+}
+
+func (x *ReservedPcis) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *ReservedPcis) GetPcis() []uint32 {
+	if x != nil {
+		return x.Pcis
+	}
+	return nil
+}
+
+type ListReservedPcisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListReservedPcisRequest) Reset() {
+	*x = ListReservedPcisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReservedPcisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservedPcisRequest) ProtoMessage() {}
+
+func (x *ListReservedPcisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservedPcisRequest.ProtoReflect.Descriptor instead.
+func (*ListReservedPcisRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{4}
+}
+
+type ListReservedPcisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reserved []*ReservedPcis `protobuf:"bytes,1,rep,name=reserved,proto3" json:"reserved,omitempty"`
+}
+
+func (x *ListReservedPcisResponse) Reset() {
+	*x = ListReservedPcisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListReservedPcisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReservedPcisResponse) ProtoMessage() {}
+
+func (x *ListReservedPcisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReservedPcisResponse.ProtoReflect.Descriptor instead.
+func (*ListReservedPcisResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListReservedPcisResponse) GetReserved() []*ReservedPcis {
+	if x != nil {
+		return x.Reserved
+	}
+	return nil
+}
+
+type SetReservedPcisRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// reserved replaces the reserved PCI list of its scope; an empty list removes it
+	Reserved *ReservedPcis `protobuf:"bytes,1,opt,name=reserved,proto3" json:"reserved,omitempty"`
+}
+
+func (x *SetReservedPcisRequest) Reset() {
+	*x = SetReservedPcisRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReservedPcisRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReservedPcisRequest) ProtoMessage() {}
+
+func (x *SetReservedPcisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReservedPcisRequest.ProtoReflect.Descriptor instead.
+func (*SetReservedPcisRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SetReservedPcisRequest) GetReserved() *ReservedPcis {
+	if x != nil {
+		return x.Reserved
+	}
+	return nil
+}
+
+type SetReservedPcisResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetReservedPcisResponse) Reset() {
+	*x = SetReservedPcisResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetReservedPcisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReservedPcisResponse) ProtoMessage() {}
+
+func (x *SetReservedPcisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReservedPcisResponse.ProtoReflect.Descriptor instead.
+func (*SetReservedPcisResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{7}
+}
+
+type LockCellRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId uint64 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+}
+
+func (x *LockCellRequest) Reset() {
+	*x = LockCellRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockCellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockCellRequest) ProtoMessage() {}
+
+func (x *LockCellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockCellRequest.ProtoReflect.Descriptor instead.
+func (*LockCellRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LockCellRequest) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+type LockCellResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *LockCellResponse) Reset() {
+	*x = LockCellResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockCellResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockCellResponse) ProtoMessage() {}
+
+func (x *LockCellResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockCellResponse.ProtoReflect.Descriptor instead.
+func (*LockCellResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{9}
+}
+
+type UnlockCellRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId uint64 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+}
+
+func (x *UnlockCellRequest) Reset() {
+	*x = UnlockCellRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockCellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockCellRequest) ProtoMessage() {}
+
+func (x *UnlockCellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockCellRequest.ProtoReflect.Descriptor instead.
+func (*UnlockCellRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UnlockCellRequest) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+type UnlockCellResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnlockCellResponse) Reset() {
+	*x = UnlockCellResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockCellResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockCellResponse) ProtoMessage() {}
+
+func (x *UnlockCellResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockCellResponse.ProtoReflect.Descriptor instead.
+func (*UnlockCellResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{11}
+}
+
+type SetPciRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId uint64 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	Pci    int32  `protobuf:"varint,2,opt,name=pci,proto3" json:"pci,omitempty"`
+}
+
+func (x *SetPciRequest) Reset() {
+	*x = SetPciRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPciRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPciRequest) ProtoMessage() {}
+
+func (x *SetPciRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPciRequest.ProtoReflect.Descriptor instead.
+func (*SetPciRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetPciRequest) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *SetPciRequest) GetPci() int32 {
+	if x != nil {
+		return x.Pci
+	}
+	return 0
+}
+
+type SetPciResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId      uint64 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	Pci         int32  `protobuf:"varint,2,opt,name=pci,proto3" json:"pci,omitempty"`
+	PreviousPci int32  `protobuf:"varint,3,opt,name=previous_pci,json=previousPci,proto3" json:"previous_pci,omitempty"`
+	// outcome is the RIC control outcome payload returned by the E2 node
+	Outcome []byte `protobuf:"bytes,4,opt,name=outcome,proto3" json:"outcome,omitempty"`
+}
+
+func (x *SetPciResponse) Reset() {
+	*x = SetPciResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPciResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPciResponse) ProtoMessage() {}
+
+func (x *SetPciResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPciResponse.ProtoReflect.Descriptor instead.
+func (*SetPciResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetPciResponse) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *SetPciResponse) GetPci() int32 {
+	if x != nil {
+		return x.Pci
+	}
+	return 0
+}
+
+func (x *SetPciResponse) GetPreviousPci() int32 {
+	if x != nil {
+		return x.PreviousPci
+	}
+	return 0
+}
+
+func (x *SetPciResponse) GetOutcome() []byte {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+// Proposal is a PCI change computed in dry-run mode, which is applied only once approved
+type Proposal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId      uint64                 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	E2NodeId    string                 `protobuf:"bytes,2,opt,name=e2_node_id,json=e2NodeId,proto3" json:"e2_node_id,omitempty"`
+	CurrentPci  int32                  `protobuf:"varint,3,opt,name=current_pci,json=currentPci,proto3" json:"current_pci,omitempty"`
+	ProposedPci int32                  `protobuf:"varint,4,opt,name=proposed_pci,json=proposedPci,proto3" json:"proposed_pci,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// neighbor_ids are the neighbors of the cell, which are affected by the change
+	NeighborIds []uint64 `protobuf:"varint,6,rep,packed,name=neighbor_ids,json=neighborIds,proto3" json:"neighbor_ids,omitempty"`
+	// reason is why the controller proposed the change
+	Reason string         `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status ProposalStatus `protobuf:"varint,8,opt,name=status,proto3,enum=onos.pci.admin.ProposalStatus" json:"status,omitempty"`
+	// decided_at and decision are only set once the proposal is not pending
+	DecidedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	Decision  string                 `protobuf:"bytes,10,opt,name=decision,proto3" json:"decision,omitempty"`
+}
+
+func (x *Proposal) Reset() {
+	*x = Proposal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proposal) ProtoMessage() {}
+
+func (x *Proposal) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proposal.ProtoReflect.Descriptor instead.
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Proposal) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *Proposal) GetE2NodeId() string {
+	if x != nil {
+		return x.E2NodeId
+	}
+	return ""
+}
+
+func (x *Proposal) GetCurrentPci() int32 {
+	if x != nil {
+		return x.CurrentPci
+	}
+	return 0
+}
+
+func (x *Proposal) GetProposedPci() int32 {
+	if x != nil {
+		return x.ProposedPci
+	}
+	return 0
+}
+
+func (x *Proposal) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Proposal) GetNeighborIds() []uint64 {
+	if x != nil {
+		return x.NeighborIds
+	}
+	return nil
+}
+
+func (x *Proposal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Proposal) GetStatus() ProposalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProposalStatus_PENDING
+}
+
+func (x *Proposal) GetDecidedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return nil
+}
+
+func (x *Proposal) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+type ListProposalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProposalsRequest) Reset() {
+	*x = ListProposalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalsRequest) ProtoMessage() {}
+
+func (x *ListProposalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ListProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{15}
+}
+
+type ListProposalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// dry_run is whether the controller is in dry-run mode
+	DryRun    bool        `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Proposals []*Proposal `protobuf:"bytes,2,rep,name=proposals,proto3" json:"proposals,omitempty"`
+}
+
+func (x *ListProposalsResponse) Reset() {
+	*x = ListProposalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalsResponse) ProtoMessage() {}
+
+func (x *ListProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ListProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListProposalsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ListProposalsResponse) GetProposals() []*Proposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+type ApproveProposalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cell_ids are the cells whose proposals are approved
+	CellIds []uint64 `protobuf:"varint,1,rep,packed,name=cell_ids,json=cellIds,proto3" json:"cell_ids,omitempty"`
+	// all approves all proposals instead of the ones of cell_ids
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+}
+
+func (x *ApproveProposalsRequest) Reset() {
+	*x = ApproveProposalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveProposalsRequest) ProtoMessage() {}
+
+func (x *ApproveProposalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ApproveProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ApproveProposalsRequest) GetCellIds() []uint64 {
+	if x != nil {
+		return x.CellIds
+	}
+	return nil
+}
+
+func (x *ApproveProposalsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+// DecisionFailure is a proposal that could not be approved or rejected
+type DecisionFailure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId uint64 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *DecisionFailure) Reset() {
+	*x = DecisionFailure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecisionFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecisionFailure) ProtoMessage() {}
+
+func (x *DecisionFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecisionFailure.ProtoReflect.Descriptor instead.
+func (*DecisionFailure) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DecisionFailure) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *DecisionFailure) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ApproveProposalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approved []*Proposal        `protobuf:"bytes,1,rep,name=approved,proto3" json:"approved,omitempty"`
+	Failed   []*DecisionFailure `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *ApproveProposalsResponse) Reset() {
+	*x = ApproveProposalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApproveProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveProposalsResponse) ProtoMessage() {}
+
+func (x *ApproveProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ApproveProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ApproveProposalsResponse) GetApproved() []*Proposal {
+	if x != nil {
+		return x.Approved
+	}
+	return nil
+}
+
+func (x *ApproveProposalsResponse) GetFailed() []*DecisionFailure {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type RejectProposalsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cell_ids are the cells whose proposals are rejected
+	CellIds []uint64 `protobuf:"varint,1,rep,packed,name=cell_ids,json=cellIds,proto3" json:"cell_ids,omitempty"`
+	// all rejects all proposals instead of the ones of cell_ids
+	All bool `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	// reason is recorded in the history with the rejected proposals
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RejectProposalsRequest) Reset() {
+	*x = RejectProposalsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectProposalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectProposalsRequest) ProtoMessage() {}
+
+func (x *RejectProposalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectProposalsRequest.ProtoReflect.Descriptor instead.
+func (*RejectProposalsRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RejectProposalsRequest) GetCellIds() []uint64 {
+	if x != nil {
+		return x.CellIds
+	}
+	return nil
+}
+
+func (x *RejectProposalsRequest) GetAll() bool {
+	if x != nil {
+		return x.All
+	}
+	return false
+}
+
+func (x *RejectProposalsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectProposalsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rejected []*Proposal        `protobuf:"bytes,1,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	Failed   []*DecisionFailure `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (x *RejectProposalsResponse) Reset() {
+	*x = RejectProposalsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectProposalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectProposalsResponse) ProtoMessage() {}
+
+func (x *RejectProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectProposalsResponse.ProtoReflect.Descriptor instead.
+func (*RejectProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RejectProposalsResponse) GetRejected() []*Proposal {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+func (x *RejectProposalsResponse) GetFailed() []*DecisionFailure {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type ListProposalHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProposalHistoryRequest) Reset() {
+	*x = ListProposalHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProposalHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalHistoryRequest) ProtoMessage() {}
+
+func (x *ListProposalHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListProposalHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{22}
+}
+
+type ListProposalHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// proposals are the decided proposals from the oldest to the newest decision
+	Proposals []*Proposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
+}
+
+func (x *ListProposalHistoryResponse) Reset() {
+	*x = ListProposalHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProposalHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProposalHistoryResponse) ProtoMessage() {}
+
+func (x *ListProposalHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProposalHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListProposalHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListProposalHistoryResponse) GetProposals() []*Proposal {
+	if x != nil {
+		return x.Proposals
+	}
+	return nil
+}
+
+// PciChange is a PCI change of a cell in the history
+type PciChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId  uint64                 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	OldPci  int32                  `protobuf:"varint,3,opt,name=old_pci,json=oldPci,proto3" json:"old_pci,omitempty"`
+	NewPci  int32                  `protobuf:"varint,4,opt,name=new_pci,json=newPci,proto3" json:"new_pci,omitempty"`
+	Trigger PciChangeTrigger       `protobuf:"varint,5,opt,name=trigger,proto3,enum=onos.pci.admin.PciChangeTrigger" json:"trigger,omitempty"`
+	Actor   PciChangeActor         `protobuf:"varint,6,opt,name=actor,proto3,enum=onos.pci.admin.PciChangeActor" json:"actor,omitempty"`
+	// conflicting_cell_ids are the cells the cell was in conflict with, if any
+	ConflictingCellIds []uint64 `protobuf:"varint,7,rep,packed,name=conflicting_cell_ids,json=conflictingCellIds,proto3" json:"conflicting_cell_ids,omitempty"`
+	// control_received is whether the outcome of the RC control message was received
+	ControlReceived bool `protobuf:"varint,8,opt,name=control_received,json=controlReceived,proto3" json:"control_received,omitempty"`
+	// control_error is the reason why the RC control message failed; empty if it succeeded
+	ControlError string `protobuf:"bytes,9,opt,name=control_error,json=controlError,proto3" json:"control_error,omitempty"`
+	// control_outcome is the RIC control outcome payload returned by the E2 node
+	ControlOutcome []byte `protobuf:"bytes,10,opt,name=control_outcome,json=controlOutcome,proto3" json:"control_outcome,omitempty"`
+	// control_failure is the class of the last failure of the RC control message; control_cause is its E2AP cause
+	ControlFailure ControlFailureType `protobuf:"varint,11,opt,name=control_failure,json=controlFailure,proto3,enum=onos.pci.admin.ControlFailureType" json:"control_failure,omitempty"`
+	ControlCause   string             `protobuf:"bytes,12,opt,name=control_cause,json=controlCause,proto3" json:"control_cause,omitempty"`
+	// control_attempts is the number of RC control messages sent, including the retries
+	ControlAttempts uint32 `protobuf:"varint,13,opt,name=control_attempts,json=controlAttempts,proto3" json:"control_attempts,omitempty"`
+	// rolled_back is whether the PCI was set back to the previous PCI after the RC control message finally failed
+	RolledBack   bool                  `protobuf:"varint,14,opt,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"`
+	Verification PciChangeVerification `protobuf:"varint,15,opt,name=verification,proto3,enum=onos.pci.admin.PciChangeVerification" json:"verification,omitempty"`
+	// reported_pci is the PCI reported by the indication message which verified the change
+	ReportedPci int32 `protobuf:"varint,16,opt,name=reported_pci,json=reportedPci,proto3" json:"reported_pci,omitempty"`
+}
+
+func (x *PciChange) Reset() {
+	*x = PciChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PciChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PciChange) ProtoMessage() {}
+
+func (x *PciChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PciChange.ProtoReflect.Descriptor instead.
+func (*PciChange) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PciChange) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *PciChange) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *PciChange) GetOldPci() int32 {
+	if x != nil {
+		return x.OldPci
+	}
+	return 0
+}
+
+func (x *PciChange) GetNewPci() int32 {
+	if x != nil {
+		return x.NewPci
+	}
+	return 0
+}
+
+func (x *PciChange) GetTrigger() PciChangeTrigger {
+	if x != nil {
+		return x.Trigger
+	}
+	return PciChangeTrigger_CONFLICT_TRIGGER
+}
+
+func (x *PciChange) GetActor() PciChangeActor {
+	if x != nil {
+		return x.Actor
+	}
+	return PciChangeActor_CONTROLLER
+}
+
+func (x *PciChange) GetConflictingCellIds() []uint64 {
+	if x != nil {
+		return x.ConflictingCellIds
+	}
+	return nil
+}
+
+func (x *PciChange) GetControlReceived() bool {
+	if x != nil {
+		return x.ControlReceived
+	}
+	return false
+}
+
+func (x *PciChange) GetControlError() string {
+	if x != nil {
+		return x.ControlError
+	}
+	return ""
+}
+
+func (x *PciChange) GetControlOutcome() []byte {
+	if x != nil {
+		return x.ControlOutcome
+	}
+	return nil
+}
+
+func (x *PciChange) GetControlFailure() ControlFailureType {
+	if x != nil {
+		return x.ControlFailure
+	}
+	return ControlFailureType_NO_FAILURE
+}
+
+func (x *PciChange) GetControlCause() string {
+	if x != nil {
+		return x.ControlCause
+	}
+	return ""
+}
+
+func (x *PciChange) GetControlAttempts() uint32 {
+	if x != nil {
+		return x.ControlAttempts
+	}
+	return 0
+}
+
+func (x *PciChange) GetRolledBack() bool {
+	if x != nil {
+		return x.RolledBack
+	}
+	return false
+}
+
+func (x *PciChange) GetVerification() PciChangeVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return PciChangeVerification_NOT_VERIFIED
+}
+
+func (x *PciChange) GetReportedPci() int32 {
+	if x != nil {
+		return x.ReportedPci
+	}
+	return 0
+}
+
+type ListPciHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cell_id filters the changes of a cell; all cells if 0
+	CellId uint64 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	// start and end filter the changes made within the time range; the range is open on the side not set
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (x *ListPciHistoryRequest) Reset() {
+	*x = ListPciHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPciHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPciHistoryRequest) ProtoMessage() {}
+
+func (x *ListPciHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPciHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListPciHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListPciHistoryRequest) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *ListPciHistoryRequest) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ListPciHistoryRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+type ListPciHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// changes are ordered by time
+	Changes []*PciChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *ListPciHistoryResponse) Reset() {
+	*x = ListPciHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPciHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPciHistoryResponse) ProtoMessage() {}
+
+func (x *ListPciHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPciHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListPciHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListPciHistoryResponse) GetChanges() []*PciChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type GetVerificationStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetVerificationStatsRequest) Reset() {
+	*x = GetVerificationStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVerificationStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerificationStatsRequest) ProtoMessage() {}
+
+func (x *GetVerificationStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerificationStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetVerificationStatsRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{27}
+}
+
+type GetVerificationStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pending changes were sent to the E2 nodes and wait for the next indication message of their cell
+	Pending   uint32 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Confirmed uint64 `protobuf:"varint,2,opt,name=confirmed,proto3" json:"confirmed,omitempty"`
+	// reverted changes were not reported by the next indication message
+	Reverted uint64 `protobuf:"varint,3,opt,name=reverted,proto3" json:"reverted,omitempty"`
+	// timed_out changes were not followed by an indication message within the verification timeout
+	TimedOut uint64 `protobuf:"varint,4,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+}
+
+func (x *GetVerificationStatsResponse) Reset() {
+	*x = GetVerificationStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVerificationStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVerificationStatsResponse) ProtoMessage() {}
+
+func (x *GetVerificationStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVerificationStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetVerificationStatsResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetVerificationStatsResponse) GetPending() uint32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *GetVerificationStatsResponse) GetConfirmed() uint64 {
+	if x != nil {
+		return x.Confirmed
+	}
+	return 0
+}
+
+func (x *GetVerificationStatsResponse) GetReverted() uint64 {
+	if x != nil {
+		return x.Reverted
+	}
+	return 0
+}
+
+func (x *GetVerificationStatsResponse) GetTimedOut() uint64 {
+	if x != nil {
+		return x.TimedOut
+	}
+	return 0
+}
+
+// FrozenCell is a cell whose PCI oscillated, so it is not changed by the controller until it is unfrozen; frozen
+// cells are kept in memory only, so they are unfrozen when onos-pci restarts or the cell is removed
+type FrozenCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId   uint64                 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	FrozenAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=frozen_at,json=frozenAt,proto3" json:"frozen_at,omitempty"`
+	// changes are the times of the PCI changes within the oscillation window
+	Changes []*timestamppb.Timestamp `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *FrozenCell) Reset() {
+	*x = FrozenCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrozenCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrozenCell) ProtoMessage() {}
+
+func (x *FrozenCell) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrozenCell.ProtoReflect.Descriptor instead.
+func (*FrozenCell) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *FrozenCell) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+func (x *FrozenCell) GetFrozenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FrozenAt
+	}
+	return nil
+}
+
+func (x *FrozenCell) GetChanges() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type ListFrozenCellsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListFrozenCellsRequest) Reset() {
+	*x = ListFrozenCellsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFrozenCellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFrozenCellsRequest) ProtoMessage() {}
+
+func (x *ListFrozenCellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFrozenCellsRequest.ProtoReflect.Descriptor instead.
+func (*ListFrozenCellsRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{30}
+}
+
+type ListFrozenCellsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*FrozenCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *ListFrozenCellsResponse) Reset() {
+	*x = ListFrozenCellsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFrozenCellsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFrozenCellsResponse) ProtoMessage() {}
+
+func (x *ListFrozenCellsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFrozenCellsResponse.ProtoReflect.Descriptor instead.
+func (*ListFrozenCellsResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListFrozenCellsResponse) GetCells() []*FrozenCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type UnfreezeCellRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId uint64 `protobuf:"varint,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+}
+
+func (x *UnfreezeCellRequest) Reset() {
+	*x = UnfreezeCellRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfreezeCellRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeCellRequest) ProtoMessage() {}
+
+func (x *UnfreezeCellRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeCellRequest.ProtoReflect.Descriptor instead.
+func (*UnfreezeCellRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UnfreezeCellRequest) GetCellId() uint64 {
+	if x != nil {
+		return x.CellId
+	}
+	return 0
+}
+
+type UnfreezeCellResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnfreezeCellResponse) Reset() {
+	*x = UnfreezeCellResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnfreezeCellResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfreezeCellResponse) ProtoMessage() {}
+
+func (x *UnfreezeCellResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfreezeCellResponse.ProtoReflect.Descriptor instead.
+func (*UnfreezeCellResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{33}
+}
+
+// Cell is a cell managed by onos-pci
+type Cell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	E2NodeId    string   `protobuf:"bytes,2,opt,name=e2_node_id,json=e2NodeId,proto3" json:"e2_node_id,omitempty"`
+	Arfcn       uint32   `protobuf:"varint,3,opt,name=arfcn,proto3" json:"arfcn,omitempty"`
+	Pci         uint32   `protobuf:"varint,4,opt,name=pci,proto3" json:"pci,omitempty"`
+	NeighborIds []uint64 `protobuf:"varint,5,rep,packed,name=neighbor_ids,json=neighborIds,proto3" json:"neighbor_ids,omitempty"`
+	Locked      bool     `protobuf:"varint,6,opt,name=locked,proto3" json:"locked,omitempty"`
+	// stale is whether the cell did not send indication messages for a while
+	Stale bool `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *Cell) Reset() {
+	*x = Cell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cell) ProtoMessage() {}
+
+func (x *Cell) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cell.ProtoReflect.Descriptor instead.
+func (*Cell) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Cell) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Cell) GetE2NodeId() string {
+	if x != nil {
+		return x.E2NodeId
+	}
+	return ""
+}
+
+func (x *Cell) GetArfcn() uint32 {
+	if x != nil {
+		return x.Arfcn
+	}
+	return 0
+}
+
+func (x *Cell) GetPci() uint32 {
+	if x != nil {
+		return x.Pci
+	}
+	return 0
+}
+
+func (x *Cell) GetNeighborIds() []uint64 {
+	if x != nil {
+		return x.NeighborIds
+	}
+	return nil
+}
+
+func (x *Cell) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *Cell) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+type WatchCellsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cell_ids, e2_node_ids and arfcns filter the cells; each empty filter matches all cells
+	CellIds   []uint64 `protobuf:"varint,1,rep,packed,name=cell_ids,json=cellIds,proto3" json:"cell_ids,omitempty"`
+	E2NodeIds []string `protobuf:"bytes,2,rep,name=e2_node_ids,json=e2NodeIds,proto3" json:"e2_node_ids,omitempty"`
+	Arfcns    []uint32 `protobuf:"varint,3,rep,packed,name=arfcns,proto3" json:"arfcns,omitempty"`
+}
+
+func (x *WatchCellsRequest) Reset() {
+	*x = WatchCellsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCellsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCellsRequest) ProtoMessage() {}
+
+func (x *WatchCellsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCellsRequest.ProtoReflect.Descriptor instead.
+func (*WatchCellsRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *WatchCellsRequest) GetCellIds() []uint64 {
+	if x != nil {
+		return x.CellIds
+	}
+	return nil
+}
+
+func (x *WatchCellsRequest) GetE2NodeIds() []string {
+	if x != nil {
+		return x.E2NodeIds
+	}
+	return nil
+}
+
+func (x *WatchCellsRequest) GetArfcns() []uint32 {
+	if x != nil {
+		return x.Arfcns
+	}
+	return nil
+}
+
+type WatchCellsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type CellEventType `protobuf:"varint,1,opt,name=type,proto3,enum=onos.pci.admin.CellEventType" json:"type,omitempty"`
+	Cell *Cell         `protobuf:"bytes,2,opt,name=cell,proto3" json:"cell,omitempty"`
+	// previous_pci is only set for CELL_PCI_CHANGED
+	PreviousPci uint32 `protobuf:"varint,3,opt,name=previous_pci,json=previousPci,proto3" json:"previous_pci,omitempty"`
+}
+
+func (x *WatchCellsResponse) Reset() {
+	*x = WatchCellsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCellsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCellsResponse) ProtoMessage() {}
+
+func (x *WatchCellsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCellsResponse.ProtoReflect.Descriptor instead.
+func (*WatchCellsResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WatchCellsResponse) GetType() CellEventType {
+	if x != nil {
+		return x.Type
+	}
+	return CellEventType_CELL_ADDED
+}
+
+func (x *WatchCellsResponse) GetCell() *Cell {
+	if x != nil {
+		return x.Cell
+	}
+	return nil
+}
+
+func (x *WatchCellsResponse) GetPreviousPci() uint32 {
+	if x != nil {
+		return x.PreviousPci
+	}
+	return 0
+}
+
+type WatchConflictsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cell_ids, e2_node_ids and arfcns filter the conflicts involving the given cells; each empty filter matches all
+	CellIds   []uint64     `protobuf:"varint,1,rep,packed,name=cell_ids,json=cellIds,proto3" json:"cell_ids,omitempty"`
+	E2NodeIds []string     `protobuf:"bytes,2,rep,name=e2_node_ids,json=e2NodeIds,proto3" json:"e2_node_ids,omitempty"`
+	Arfcns    []uint32     `protobuf:"varint,3,rep,packed,name=arfcns,proto3" json:"arfcns,omitempty"`
+	Type      ConflictType `protobuf:"varint,4,opt,name=type,proto3,enum=onos.pci.admin.ConflictType" json:"type,omitempty"`
+}
+
+func (x *WatchConflictsRequest) Reset() {
+	*x = WatchConflictsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchConflictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchConflictsRequest) ProtoMessage() {}
+
+func (x *WatchConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchConflictsRequest.ProtoReflect.Descriptor instead.
+func (*WatchConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WatchConflictsRequest) GetCellIds() []uint64 {
+	if x != nil {
+		return x.CellIds
+	}
+	return nil
+}
+
+func (x *WatchConflictsRequest) GetE2NodeIds() []string {
+	if x != nil {
+		return x.E2NodeIds
+	}
+	return nil
+}
+
+func (x *WatchConflictsRequest) GetArfcns() []uint32 {
+	if x != nil {
+		return x.Arfcns
+	}
+	return nil
+}
+
+func (x *WatchConflictsRequest) GetType() ConflictType {
+	if x != nil {
+		return x.Type
+	}
+	return ConflictType_ANY_CONFLICT
+}
+
+type WatchConflictsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     ConflictEventType `protobuf:"varint,1,opt,name=type,proto3,enum=onos.pci.admin.ConflictEventType" json:"type,omitempty"`
+	Conflict *Conflict         `protobuf:"bytes,2,opt,name=conflict,proto3" json:"conflict,omitempty"`
+}
+
+func (x *WatchConflictsResponse) Reset() {
+	*x = WatchConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_admin_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchConflictsResponse) ProtoMessage() {}
+
+func (x *WatchConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchConflictsResponse.ProtoReflect.Descriptor instead.
+func (*WatchConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *WatchConflictsResponse) GetType() ConflictEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ConflictEventType_CONFLICT_DETECTED
+}
+
+func (x *WatchConflictsResponse) GetConflict() *Conflict {
+	if x != nil {
+		return x.Conflict
+	}
+	return nil
+}
+
+var File_api_admin_proto protoreflect.FileDescriptor
+
+var file_api_admin_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xcd, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12,
+	0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x70, 0x65,
+	0x65, 0x72, 0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x70, 0x65, 0x65, 0x72, 0x43, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x4e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x63,
+	0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x63, 0x69, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x72, 0x66, 0x63, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x72, 0x66,
+	0x63, 0x6e, 0x22, 0x61, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65,
+	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c,
+	0x6c, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x4f, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x22, 0x8d, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x64, 0x50, 0x63, 0x69, 0x73, 0x12, 0x36, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63,
+	0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x6c, 0x6d, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x70, 0x6c, 0x6d, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x63, 0x69, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d,
+	0x52, 0x04, 0x70, 0x63, 0x69, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x54, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x50, 0x63, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69, 0x73, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x22, 0x52, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69,
+	0x73, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x22, 0x19, 0x0a, 0x17, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x0f, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x65,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c,
+	0x49, 0x64, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x11, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65,
+	0x6c, 0x6c, 0x49, 0x64, 0x22, 0x14, 0x0a, 0x12, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3a, 0x0a, 0x0d, 0x53, 0x65,
+	0x74, 0x50, 0x63, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65,
+	0x6c, 0x6c, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x63, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x70, 0x63, 0x69, 0x22, 0x78, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x50, 0x63, 0x69,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49,
+	0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x63, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03,
+	0x70, 0x63, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x70, 0x63, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x50, 0x63, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65,
+	0x22, 0x8a, 0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x12, 0x17, 0x0a,
+	0x07, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x65, 0x32, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x32, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x63, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x50, 0x63, 0x69, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x64, 0x5f, 0x70, 0x63, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x64, 0x50, 0x63, 0x69, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x65, 0x69, 0x67, 0x68,
+	0x62, 0x6f, 0x72, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x36,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e,
+	0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x16, 0x0a,
+	0x14, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x68, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12, 0x36, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x6f,
+	0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x22,
+	0x46, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x65,
+	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x63, 0x65,
+	0x6c, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x22, 0x42, 0x0a, 0x0f, 0x44, 0x65, 0x63, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65,
+	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c,
+	0x6c, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x89, 0x01, 0x0a, 0x18,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72,
+	0x6f, 0x76, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x6f,
+	0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x37,
+	0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52,
+	0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x22, 0x5d, 0x0a, 0x16, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x61, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x6c, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x08,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e,
+	0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x55, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x22, 0xcf, 0x05, 0x0a, 0x09, 0x50, 0x63, 0x69, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x2e, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a,
+	0x07, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x63, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x6f, 0x6c, 0x64, 0x50, 0x63, 0x69, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x63,
+	0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x50, 0x63, 0x69, 0x12,
+	0x3a, 0x0a, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x20, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x50, 0x63, 0x69, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x52, 0x07, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6f, 0x6e, 0x6f,
+	0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x63, 0x69, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x04, 0x52,
+	0x12, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x6c, 0x6c,
+	0x49, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x6f,
+	0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x75, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x64, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x64, 0x42, 0x61, 0x63, 0x6b, 0x12, 0x49, 0x0a, 0x0c, 0x76, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x50, 0x63, 0x69, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x63, 0x69, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x64, 0x50, 0x63, 0x69, 0x22, 0x90, 0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x63, 0x69, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a,
+	0x03, 0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x4d, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x63, 0x69, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63,
+	0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x50, 0x63, 0x69, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x1c, 0x47, 0x65,
+	0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x64, 0x4f, 0x75, 0x74, 0x22, 0x94, 0x01, 0x0a, 0x0a,
+	0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65,
+	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x65, 0x6c,
+	0x6c, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e,
+	0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4b, 0x0a, 0x17,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63,
+	0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x43, 0x65,
+	0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x2e, 0x0a, 0x13, 0x55, 0x6e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x66,
+	0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0xad, 0x01, 0x0a, 0x04, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x0a, 0x65, 0x32,
+	0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x32, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x72, 0x66, 0x63,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x61, 0x72, 0x66, 0x63, 0x6e, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x63, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70, 0x63, 0x69,
+	0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x65, 0x69, 0x67, 0x68, 0x62, 0x6f, 0x72,
+	0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6c,
+	0x65, 0x22, 0x66, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x63, 0x65, 0x6c, 0x6c, 0x49, 0x64,
+	0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x32, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x32, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x64,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x66, 0x63, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0d, 0x52, 0x06, 0x61, 0x72, 0x66, 0x63, 0x6e, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x12, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d,
+	0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x43, 0x65, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x04, 0x63, 0x65, 0x6c, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x63, 0x69, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x63, 0x69,
+	0x22, 0x9c, 0x01, 0x0a, 0x15, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x65,
+	0x6c, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x04, 0x52, 0x07, 0x63, 0x65,
+	0x6c, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x65, 0x32, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x32, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x72, 0x66, 0x63, 0x6e, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x72, 0x66, 0x63, 0x6e, 0x73, 0x12, 0x30, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6f, 0x6e,
+	0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
+	0x85, 0x01, 0x0a, 0x16, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e,
+	0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x34, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x08, 0x63,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x2a, 0x3e, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x6c,
+	0x69, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x41, 0x4e, 0x59, 0x5f, 0x43,
+	0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4c,
+	0x4c, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x46,
+	0x55, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x35, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x47,
+	0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x50, 0x4c, 0x4d, 0x4e, 0x10,
+	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x02, 0x2a, 0x56,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x41, 0x50, 0x50, 0x52, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52,
+	0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x58, 0x50,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55, 0x50, 0x45, 0x52, 0x53,
+	0x45, 0x44, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x82, 0x01, 0x0a, 0x10, 0x50, 0x63, 0x69, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x10, 0x43,
+	0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x10,
+	0x00, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x50, 0x54, 0x49, 0x4d, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x4d,
+	0x41, 0x4e, 0x55, 0x41, 0x4c, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x10, 0x02, 0x12,
+	0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x50, 0x4f, 0x53, 0x41, 0x4c, 0x5f, 0x54, 0x52, 0x49, 0x47,
+	0x47, 0x45, 0x52, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x4c, 0x42, 0x41, 0x43,
+	0x4b, 0x5f, 0x54, 0x52, 0x49, 0x47, 0x47, 0x45, 0x52, 0x10, 0x04, 0x2a, 0x2e, 0x0a, 0x0e, 0x50,
+	0x63, 0x69, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x0e, 0x0a,
+	0x0a, 0x43, 0x4f, 0x4e, 0x54, 0x52, 0x4f, 0x4c, 0x4c, 0x45, 0x52, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x4f, 0x50, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x2a, 0x61, 0x0a, 0x12, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x4e, 0x4f, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10,
+	0x00, 0x12, 0x11, 0x0a, 0x0d, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55,
+	0x52, 0x45, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x32, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x55, 0x52, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x49, 0x43, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x52, 0x4f, 0x4c, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x03, 0x2a, 0x5d,
+	0x0a, 0x15, 0x50, 0x63, 0x69, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x0c, 0x4e, 0x4f, 0x54, 0x5f, 0x56,
+	0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x45, 0x56, 0x45,
+	0x52, 0x54, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x2a, 0x59, 0x0a,
+	0x0d, 0x43, 0x65, 0x6c, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0e,
+	0x0a, 0x0a, 0x43, 0x45, 0x4c, 0x4c, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14,
+	0x0a, 0x10, 0x43, 0x45, 0x4c, 0x4c, 0x5f, 0x50, 0x43, 0x49, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x45, 0x4c, 0x4c, 0x5f, 0x55, 0x50, 0x44,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x45, 0x4c, 0x4c, 0x5f, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x41, 0x0a, 0x11, 0x43, 0x6f, 0x6e, 0x66,
+	0x6c, 0x69, 0x63, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x15, 0x0a,
+	0x11, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x44, 0x45, 0x54, 0x45, 0x43, 0x54,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54,
+	0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x01, 0x32, 0x86, 0x0c, 0x0a, 0x08,
+	0x50, 0x63, 0x69, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x5c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x6f, 0x6e, 0x6f, 0x73,
+	0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69, 0x73, 0x12, 0x27, 0x2e, 0x6f, 0x6e, 0x6f,
+	0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x64, 0x50, 0x63, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x0f, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69, 0x73,
+	0x12, 0x26, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e,
+	0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x64, 0x50, 0x63, 0x69, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x4d, 0x0a, 0x08, 0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x1f, 0x2e,
+	0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c,
+	0x6f, 0x63, 0x6b, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x53, 0x0a, 0x0a, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x21,
+	0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x50, 0x63, 0x69, 0x12,
+	0x1d, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x53, 0x65, 0x74, 0x50, 0x63, 0x69, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x53, 0x65, 0x74, 0x50, 0x63, 0x69, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
+	0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12,
+	0x24, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73,
+	0x12, 0x27, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61,
+	0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6f, 0x6e, 0x6f, 0x73,
+	0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x26, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63,
+	0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x2a,
+	0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6f, 0x6e, 0x6f,
+	0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x63, 0x69, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x25, 0x2e, 0x6f, 0x6e, 0x6f, 0x73,
+	0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x63, 0x69, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x63, 0x69, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x2b, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x26,
+	0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63,
+	0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x72, 0x6f, 0x7a,
+	0x65, 0x6e, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x59, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x12,
+	0x23, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e,
+	0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x55, 0x6e, 0x66, 0x72, 0x65, 0x65, 0x7a, 0x65, 0x43, 0x65,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0a, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x12, 0x21, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e,
+	0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43,
+	0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6f, 0x6e,
+	0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x43, 0x65, 0x6c, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30,
+	0x01, 0x12, 0x61, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x12, 0x25, 0x2e, 0x6f, 0x6e, 0x6f, 0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6f, 0x6e, 0x6f,
+	0x73, 0x2e, 0x70, 0x63, 0x69, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6f, 0x6e, 0x6f, 0x73, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x2f, 0x6f,
+	0x6e, 0x6f, 0x73, 0x2d, 0x70, 0x63, 0x69, 0x2f, 0x61, 0x70, 0x69, 0x3b, 0x61, 0x70, 0x69, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_admin_proto_rawDescOnce sync.Once
+	file_api_admin_proto_rawDescData = file_api_admin_proto_rawDesc
+)
+
+func file_api_admin_proto_rawDescGZIP() []byte {
+	file_api_admin_proto_rawDescOnce.Do(func() {
+		file_api_admin_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_admin_proto_rawDescData)
+	})
+	return file_api_admin_proto_rawDescData
+}
+
+var file_api_admin_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_api_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_api_admin_proto_goTypes = []interface{}{
+	(ConflictType)(0),                    // 0: onos.pci.admin.ConflictType
+	(ReservationScope)(0),                // 1: onos.pci.admin.ReservationScope
+	(ProposalStatus)(0),                  // 2: onos.pci.admin.ProposalStatus
+	(PciChangeTrigger)(0),                // 3: onos.pci.admin.PciChangeTrigger
+	(PciChangeActor)(0),                  // 4: onos.pci.admin.PciChangeActor
+	(ControlFailureType)(0),              // 5: onos.pci.admin.ControlFailureType
+	(PciChangeVerification)(0),           // 6: onos.pci.admin.PciChangeVerification
+	(CellEventType)(0),                   // 7: onos.pci.admin.CellEventType
+	(ConflictEventType)(0),               // 8: onos.pci.admin.ConflictEventType
+	(*Conflict)(nil),                     // 9: onos.pci.admin.Conflict
+	(*ListConflictsRequest)(nil),         // 10: onos.pci.admin.ListConflictsRequest
+	(*ListConflictsResponse)(nil),        // 11: onos.pci.admin.ListConflictsResponse
+	(*ReservedPcis)(nil),                 // 12: onos.pci.admin.ReservedPcis
+	(*ListReservedPcisRequest)(nil),      // 13: onos.pci.admin.ListReservedPcisRequest
+	(*ListReservedPcisResponse)(nil),     // 14: onos.pci.admin.ListReservedPcisResponse
+	(*SetReservedPcisRequest)(nil),       // 15: onos.pci.admin.SetReservedPcisRequest
+	(*SetReservedPcisResponse)(nil),      // 16: onos.pci.admin.SetReservedPcisResponse
+	(*LockCellRequest)(nil),              // 17: onos.pci.admin.LockCellRequest
+	(*LockCellResponse)(nil),             // 18: onos.pci.admin.LockCellResponse
+	(*UnlockCellRequest)(nil),            // 19: onos.pci.admin.UnlockCellRequest
+	(*UnlockCellResponse)(nil),           // 20: onos.pci.admin.UnlockCellResponse
+	(*SetPciRequest)(nil),                // 21: onos.pci.admin.SetPciRequest
+	(*SetPciResponse)(nil),               // 22: onos.pci.admin.SetPciResponse
+	(*Proposal)(nil),                     // 23: onos.pci.admin.Proposal
+	(*ListProposalsRequest)(nil),         // 24: onos.pci.admin.ListProposalsRequest
+	(*ListProposalsResponse)(nil),        // 25: onos.pci.admin.ListProposalsResponse
+	(*ApproveProposalsRequest)(nil),      // 26: onos.pci.admin.ApproveProposalsRequest
+	(*DecisionFailure)(nil),              // 27: onos.pci.admin.DecisionFailure
+	(*ApproveProposalsResponse)(nil),     // 28: onos.pci.admin.ApproveProposalsResponse
+	(*RejectProposalsRequest)(nil),       // 29: onos.pci.admin.RejectProposalsRequest
+	(*RejectProposalsResponse)(nil),      // 30: onos.pci.admin.RejectProposalsResponse
+	(*ListProposalHistoryRequest)(nil),   // 31: onos.pci.admin.ListProposalHistoryRequest
+	(*ListProposalHistoryResponse)(nil),  // 32: onos.pci.admin.ListProposalHistoryResponse
+	(*PciChange)(nil),                    // 33: onos.pci.admin.PciChange
+	(*ListPciHistoryRequest)(nil),        // 34: onos.pci.admin.ListPciHistoryRequest
+	(*ListPciHistoryResponse)(nil),       // 35: onos.pci.admin.ListPciHistoryResponse
+	(*GetVerificationStatsRequest)(nil),  // 36: onos.pci.admin.GetVerificationStatsRequest
+	(*GetVerificationStatsResponse)(nil), // 37: onos.pci.admin.GetVerificationStatsResponse
+	(*FrozenCell)(nil),                   // 38: onos.pci.admin.FrozenCell
+	(*ListFrozenCellsRequest)(nil),       // 39: onos.pci.admin.ListFrozenCellsRequest
+	(*ListFrozenCellsResponse)(nil),      // 40: onos.pci.admin.ListFrozenCellsResponse
+	(*UnfreezeCellRequest)(nil),          // 41: onos.pci.admin.UnfreezeCellRequest
+	(*UnfreezeCellResponse)(nil),         // 42: onos.pci.admin.UnfreezeCellResponse
+	(*Cell)(nil),                         // 43: onos.pci.admin.Cell
+	(*WatchCellsRequest)(nil),            // 44: onos.pci.admin.WatchCellsRequest
+	(*WatchCellsResponse)(nil),           // 45: onos.pci.admin.WatchCellsResponse
+	(*WatchConflictsRequest)(nil),        // 46: onos.pci.admin.WatchConflictsRequest
+	(*WatchConflictsResponse)(nil),       // 47: onos.pci.admin.WatchConflictsResponse
+	(*timestamppb.Timestamp)(nil),        // 48: google.protobuf.Timestamp
+}
+var file_api_admin_proto_depIdxs = []int32{
+	0,  // 0: onos.pci.admin.Conflict.type:type_name -> onos.pci.admin.ConflictType
+	0,  // 1: onos.pci.admin.ListConflictsRequest.type:type_name -> onos.pci.admin.ConflictType
+	9,  // 2: onos.pci.admin.ListConflictsResponse.conflicts:type_name -> onos.pci.admin.Conflict
+	1,  // 3: onos.pci.admin.ReservedPcis.scope:type_name -> onos.pci.admin.ReservationScope
+	12, // 4: onos.pci.admin.ListReservedPcisResponse.reserved:type_name -> onos.pci.admin.ReservedPcis
+	12, // 5: onos.pci.admin.SetReservedPcisRequest.reserved:type_name -> onos.pci.admin.ReservedPcis
+	48, // 6: onos.pci.admin.Proposal.created_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: onos.pci.admin.Proposal.status:type_name -> onos.pci.admin.ProposalStatus
+	48, // 8: onos.pci.admin.Proposal.decided_at:type_name -> google.protobuf.Timestamp
+	23, // 9: onos.pci.admin.ListProposalsResponse.proposals:type_name -> onos.pci.admin.Proposal
+	23, // 10: onos.pci.admin.ApproveProposalsResponse.approved:type_name -> onos.pci.admin.Proposal
+	27, // 11: onos.pci.admin.ApproveProposalsResponse.failed:type_name -> onos.pci.admin.DecisionFailure
+	23, // 12: onos.pci.admin.RejectProposalsResponse.rejected:type_name -> onos.pci.admin.Proposal
+	27, // 13: onos.pci.admin.RejectProposalsResponse.failed:type_name -> onos.pci.admin.DecisionFailure
+	23, // 14: onos.pci.admin.ListProposalHistoryResponse.proposals:type_name -> onos.pci.admin.Proposal
+	48, // 15: onos.pci.admin.PciChange.time:type_name -> google.protobuf.Timestamp
+	3,  // 16: onos.pci.admin.PciChange.trigger:type_name -> onos.pci.admin.PciChangeTrigger
+	4,  // 17: onos.pci.admin.PciChange.actor:type_name -> onos.pci.admin.PciChangeActor
+	5,  // 18: onos.pci.admin.PciChange.control_failure:type_name -> onos.pci.admin.ControlFailureType
+	6,  // 19: onos.pci.admin.PciChange.verification:type_name -> onos.pci.admin.PciChangeVerification
+	48, // 20: onos.pci.admin.ListPciHistoryRequest.start:type_name -> google.protobuf.Timestamp
+	48, // 21: onos.pci.admin.ListPciHistoryRequest.end:type_name -> google.protobuf.Timestamp
+	33, // 22: onos.pci.admin.ListPciHistoryResponse.changes:type_name -> onos.pci.admin.PciChange
+	48, // 23: onos.pci.admin.FrozenCell.frozen_at:type_name -> google.protobuf.Timestamp
+	48, // 24: onos.pci.admin.FrozenCell.changes:type_name -> google.protobuf.Timestamp
+	38, // 25: onos.pci.admin.ListFrozenCellsResponse.cells:type_name -> onos.pci.admin.FrozenCell
+	7,  // 26: onos.pci.admin.WatchCellsResponse.type:type_name -> onos.pci.admin.CellEventType
+	43, // 27: onos.pci.admin.WatchCellsResponse.cell:type_name -> onos.pci.admin.Cell
+	0,  // 28: onos.pci.admin.WatchConflictsRequest.type:type_name -> onos.pci.admin.ConflictType
+	8,  // 29: onos.pci.admin.WatchConflictsResponse.type:type_name -> onos.pci.admin.ConflictEventType
+	9,  // 30: onos.pci.admin.WatchConflictsResponse.conflict:type_name -> onos.pci.admin.Conflict
+	10, // 31: onos.pci.admin.PciAdmin.ListConflicts:input_type -> onos.pci.admin.ListConflictsRequest
+	13, // 32: onos.pci.admin.PciAdmin.ListReservedPcis:input_type -> onos.pci.admin.ListReservedPcisRequest
+	15, // 33: onos.pci.admin.PciAdmin.SetReservedPcis:input_type -> onos.pci.admin.SetReservedPcisRequest
+	17, // 34: onos.pci.admin.PciAdmin.LockCell:input_type -> onos.pci.admin.LockCellRequest
+	19, // 35: onos.pci.admin.PciAdmin.UnlockCell:input_type -> onos.pci.admin.UnlockCellRequest
+	21, // 36: onos.pci.admin.PciAdmin.SetPci:input_type -> onos.pci.admin.SetPciRequest
+	24, // 37: onos.pci.admin.PciAdmin.ListProposals:input_type -> onos.pci.admin.ListProposalsRequest
+	26, // 38: onos.pci.admin.PciAdmin.ApproveProposals:input_type -> onos.pci.admin.ApproveProposalsRequest
+	29, // 39: onos.pci.admin.PciAdmin.RejectProposals:input_type -> onos.pci.admin.RejectProposalsRequest
+	31, // 40: onos.pci.admin.PciAdmin.ListProposalHistory:input_type -> onos.pci.admin.ListProposalHistoryRequest
+	34, // 41: onos.pci.admin.PciAdmin.ListPciHistory:input_type -> onos.pci.admin.ListPciHistoryRequest
+	36, // 42: onos.pci.admin.PciAdmin.GetVerificationStats:input_type -> onos.pci.admin.GetVerificationStatsRequest
+	39, // 43: onos.pci.admin.PciAdmin.ListFrozenCells:input_type -> onos.pci.admin.ListFrozenCellsRequest
+	41, // 44: onos.pci.admin.PciAdmin.UnfreezeCell:input_type -> onos.pci.admin.UnfreezeCellRequest
+	44, // 45: onos.pci.admin.PciAdmin.WatchCells:input_type -> onos.pci.admin.WatchCellsRequest
+	46, // 46: onos.pci.admin.PciAdmin.WatchConflicts:input_type -> onos.pci.admin.WatchConflictsRequest
+	11, // 47: onos.pci.admin.PciAdmin.ListConflicts:output_type -> onos.pci.admin.ListConflictsResponse
+	14, // 48: onos.pci.admin.PciAdmin.ListReservedPcis:output_type -> onos.pci.admin.ListReservedPcisResponse
+	16, // 49: onos.pci.admin.PciAdmin.SetReservedPcis:output_type -> onos.pci.admin.SetReservedPcisResponse
+	18, // 50: onos.pci.admin.PciAdmin.LockCell:output_type -> onos.pci.admin.LockCellResponse
+	20, // 51: onos.pci.admin.PciAdmin.UnlockCell:output_type -> onos.pci.admin.UnlockCellResponse
+	22, // 52: onos.pci.admin.PciAdmin.SetPci:output_type -> onos.pci.admin.SetPciResponse
+	25, // 53: onos.pci.admin.PciAdmin.ListProposals:output_type -> onos.pci.admin.ListProposalsResponse
+	28, // 54: onos.pci.admin.PciAdmin.ApproveProposals:output_type -> onos.pci.admin.ApproveProposalsResponse
+	30, // 55: onos.pci.admin.PciAdmin.RejectProposals:output_type -> onos.pci.admin.RejectProposalsResponse
+	32, // 56: onos.pci.admin.PciAdmin.ListProposalHistory:output_type -> onos.pci.admin.ListProposalHistoryResponse
+	35, // 57: onos.pci.admin.PciAdmin.ListPciHistory:output_type -> onos.pci.admin.ListPciHistoryResponse
+	37, // 58: onos.pci.admin.PciAdmin.GetVerificationStats:output_type -> onos.pci.admin.GetVerificationStatsResponse
+	40, // 59: onos.pci.admin.PciAdmin.ListFrozenCells:output_type -> onos.pci.admin.ListFrozenCellsResponse
+	42, // 60: onos.pci.admin.PciAdmin.UnfreezeCell:output_type -> onos.pci.admin.UnfreezeCellResponse
+	45, // 61: onos.pci.admin.PciAdmin.WatchCells:output_type -> onos.pci.admin.WatchCellsResponse
+	47, // 62: onos.pci.admin.PciAdmin.WatchConflicts:output_type -> onos.pci.admin.WatchConflictsResponse
+	47, // [47:63] is the sub-list for method output_type
+	31, // [31:47] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_api_admin_proto_init() }
+func file_api_admin_proto_init() {
+	if File_api_admin_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_admin_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Conflict); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConflictsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConflictsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReservedPcis); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReservedPcisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListReservedPcisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReservedPcisRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetReservedPcisResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockCellRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LockCellResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockCellRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockCellResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPciRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPciResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProposalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProposalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveProposalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecisionFailure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApproveProposalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectProposalsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectProposalsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProposalHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProposalHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PciChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPciHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPciHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVerificationStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVerificationStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrozenCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFrozenCellsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFrozenCellsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeCellRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnfreezeCellResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Cell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCellsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCellsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchConflictsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_admin_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchConflictsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_admin_proto_rawDesc,
+			NumEnums:      9,
+			NumMessages:   39,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_admin_proto_goTypes,
+		DependencyIndexes: file_api_admin_proto_depIdxs,
+		EnumInfos:         file_api_admin_proto_enumTypes,
+		MessageInfos:      file_api_admin_proto_msgTypes,
+	}.Build()
+	File_api_admin_proto = out.File
+	file_api_admin_proto_rawDesc = nil
+	file_api_admin_proto_goTypes = nil
+	file_api_admin_proto_depIdxs = nil
+}
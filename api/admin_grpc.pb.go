@@ -0,0 +1,734 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: api/admin.proto
+
+package api
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PciAdminClient is the client API for PciAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PciAdminClient interface {
+	// ListConflicts returns the PCI collisions and confusions with the pair of cells involved
+	ListConflicts(ctx context.Context, in *ListConflictsRequest, opts ...grpc.CallOption) (*ListConflictsResponse, error)
+	// ListReservedPcis returns the reserved PCI lists of all scopes
+	ListReservedPcis(ctx context.Context, in *ListReservedPcisRequest, opts ...grpc.CallOption) (*ListReservedPcisResponse, error)
+	// SetReservedPcis replaces the reserved PCI list of a scope; it applies to the next PCI assignments
+	SetReservedPcis(ctx context.Context, in *SetReservedPcisRequest, opts ...grpc.CallOption) (*SetReservedPcisResponse, error)
+	// LockCell pins the current PCI of a cell, so that the PCI conflicts are resolved by changing the other cells
+	LockCell(ctx context.Context, in *LockCellRequest, opts ...grpc.CallOption) (*LockCellResponse, error)
+	// UnlockCell lets the PCI of a locked cell be changed again
+	UnlockCell(ctx context.Context, in *UnlockCellRequest, opts ...grpc.CallOption) (*UnlockCellResponse, error)
+	// SetPci sets the PCI of a cell manually and returns the outcome of the RC control message sent to the E2 node
+	SetPci(ctx context.Context, in *SetPciRequest, opts ...grpc.CallOption) (*SetPciResponse, error)
+	// ListProposals returns the PCI changes proposed in dry-run mode
+	ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error)
+	// ApproveProposals applies the PCI changes proposed for the given cells, or all of them
+	ApproveProposals(ctx context.Context, in *ApproveProposalsRequest, opts ...grpc.CallOption) (*ApproveProposalsResponse, error)
+	// RejectProposals rejects the PCI changes proposed for the given cells, or all of them
+	RejectProposals(ctx context.Context, in *RejectProposalsRequest, opts ...grpc.CallOption) (*RejectProposalsResponse, error)
+	// ListProposalHistory returns the approved, rejected, expired and superseded proposals
+	ListProposalHistory(ctx context.Context, in *ListProposalHistoryRequest, opts ...grpc.CallOption) (*ListProposalHistoryResponse, error)
+	// ListPciHistory returns the PCI changes of a cell, or of all cells, within a time range
+	ListPciHistory(ctx context.Context, in *ListPciHistoryRequest, opts ...grpc.CallOption) (*ListPciHistoryResponse, error)
+	// GetVerificationStats returns the numbers of PCI changes pending, confirmed, reverted and timed out
+	// in the verification against the next indication message of their cell
+	GetVerificationStats(ctx context.Context, in *GetVerificationStatsRequest, opts ...grpc.CallOption) (*GetVerificationStatsResponse, error)
+	// ListFrozenCells lists the cells frozen after their PCI oscillated since onos-pci started; frozen cells are
+	// not persisted
+	ListFrozenCells(ctx context.Context, in *ListFrozenCellsRequest, opts ...grpc.CallOption) (*ListFrozenCellsResponse, error)
+	// UnfreezeCell lets the controller change the PCI of a frozen cell again
+	UnfreezeCell(ctx context.Context, in *UnfreezeCellRequest, opts ...grpc.CallOption) (*UnfreezeCellResponse, error)
+	// WatchCells streams the existing cells, then their additions, PCI changes, updates and removals
+	WatchCells(ctx context.Context, in *WatchCellsRequest, opts ...grpc.CallOption) (PciAdmin_WatchCellsClient, error)
+	// WatchConflicts streams the existing PCI conflicts, then the conflicts as they are detected and resolved
+	WatchConflicts(ctx context.Context, in *WatchConflictsRequest, opts ...grpc.CallOption) (PciAdmin_WatchConflictsClient, error)
+}
+
+type pciAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPciAdminClient(cc grpc.ClientConnInterface) PciAdminClient {
+	return &pciAdminClient{cc}
+}
+
+func (c *pciAdminClient) ListConflicts(ctx context.Context, in *ListConflictsRequest, opts ...grpc.CallOption) (*ListConflictsResponse, error) {
+	out := new(ListConflictsResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/ListConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) ListReservedPcis(ctx context.Context, in *ListReservedPcisRequest, opts ...grpc.CallOption) (*ListReservedPcisResponse, error) {
+	out := new(ListReservedPcisResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/ListReservedPcis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) SetReservedPcis(ctx context.Context, in *SetReservedPcisRequest, opts ...grpc.CallOption) (*SetReservedPcisResponse, error) {
+	out := new(SetReservedPcisResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/SetReservedPcis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) LockCell(ctx context.Context, in *LockCellRequest, opts ...grpc.CallOption) (*LockCellResponse, error) {
+	out := new(LockCellResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/LockCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) UnlockCell(ctx context.Context, in *UnlockCellRequest, opts ...grpc.CallOption) (*UnlockCellResponse, error) {
+	out := new(UnlockCellResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/UnlockCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) SetPci(ctx context.Context, in *SetPciRequest, opts ...grpc.CallOption) (*SetPciResponse, error) {
+	out := new(SetPciResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/SetPci", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) ListProposals(ctx context.Context, in *ListProposalsRequest, opts ...grpc.CallOption) (*ListProposalsResponse, error) {
+	out := new(ListProposalsResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/ListProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) ApproveProposals(ctx context.Context, in *ApproveProposalsRequest, opts ...grpc.CallOption) (*ApproveProposalsResponse, error) {
+	out := new(ApproveProposalsResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/ApproveProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) RejectProposals(ctx context.Context, in *RejectProposalsRequest, opts ...grpc.CallOption) (*RejectProposalsResponse, error) {
+	out := new(RejectProposalsResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/RejectProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) ListProposalHistory(ctx context.Context, in *ListProposalHistoryRequest, opts ...grpc.CallOption) (*ListProposalHistoryResponse, error) {
+	out := new(ListProposalHistoryResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/ListProposalHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) ListPciHistory(ctx context.Context, in *ListPciHistoryRequest, opts ...grpc.CallOption) (*ListPciHistoryResponse, error) {
+	out := new(ListPciHistoryResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/ListPciHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) GetVerificationStats(ctx context.Context, in *GetVerificationStatsRequest, opts ...grpc.CallOption) (*GetVerificationStatsResponse, error) {
+	out := new(GetVerificationStatsResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/GetVerificationStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) ListFrozenCells(ctx context.Context, in *ListFrozenCellsRequest, opts ...grpc.CallOption) (*ListFrozenCellsResponse, error) {
+	out := new(ListFrozenCellsResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/ListFrozenCells", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) UnfreezeCell(ctx context.Context, in *UnfreezeCellRequest, opts ...grpc.CallOption) (*UnfreezeCellResponse, error) {
+	out := new(UnfreezeCellResponse)
+	err := c.cc.Invoke(ctx, "/onos.pci.admin.PciAdmin/UnfreezeCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pciAdminClient) WatchCells(ctx context.Context, in *WatchCellsRequest, opts ...grpc.CallOption) (PciAdmin_WatchCellsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PciAdmin_ServiceDesc.Streams[0], "/onos.pci.admin.PciAdmin/WatchCells", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pciAdminWatchCellsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PciAdmin_WatchCellsClient interface {
+	Recv() (*WatchCellsResponse, error)
+	grpc.ClientStream
+}
+
+type pciAdminWatchCellsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pciAdminWatchCellsClient) Recv() (*WatchCellsResponse, error) {
+	m := new(WatchCellsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pciAdminClient) WatchConflicts(ctx context.Context, in *WatchConflictsRequest, opts ...grpc.CallOption) (PciAdmin_WatchConflictsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PciAdmin_ServiceDesc.Streams[1], "/onos.pci.admin.PciAdmin/WatchConflicts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pciAdminWatchConflictsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PciAdmin_WatchConflictsClient interface {
+	Recv() (*WatchConflictsResponse, error)
+	grpc.ClientStream
+}
+
+type pciAdminWatchConflictsClient struct {
+	grpc.ClientStream
+}
+
+func (x *pciAdminWatchConflictsClient) Recv() (*WatchConflictsResponse, error) {
+	m := new(WatchConflictsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PciAdminServer is the server API for PciAdmin service.
+// All implementations should embed UnimplementedPciAdminServer
+// for forward compatibility
+type PciAdminServer interface {
+	// ListConflicts returns the PCI collisions and confusions with the pair of cells involved
+	ListConflicts(context.Context, *ListConflictsRequest) (*ListConflictsResponse, error)
+	// ListReservedPcis returns the reserved PCI lists of all scopes
+	ListReservedPcis(context.Context, *ListReservedPcisRequest) (*ListReservedPcisResponse, error)
+	// SetReservedPcis replaces the reserved PCI list of a scope; it applies to the next PCI assignments
+	SetReservedPcis(context.Context, *SetReservedPcisRequest) (*SetReservedPcisResponse, error)
+	// LockCell pins the current PCI of a cell, so that the PCI conflicts are resolved by changing the other cells
+	LockCell(context.Context, *LockCellRequest) (*LockCellResponse, error)
+	// UnlockCell lets the PCI of a locked cell be changed again
+	UnlockCell(context.Context, *UnlockCellRequest) (*UnlockCellResponse, error)
+	// SetPci sets the PCI of a cell manually and returns the outcome of the RC control message sent to the E2 node
+	SetPci(context.Context, *SetPciRequest) (*SetPciResponse, error)
+	// ListProposals returns the PCI changes proposed in dry-run mode
+	ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error)
+	// ApproveProposals applies the PCI changes proposed for the given cells, or all of them
+	ApproveProposals(context.Context, *ApproveProposalsRequest) (*ApproveProposalsResponse, error)
+	// RejectProposals rejects the PCI changes proposed for the given cells, or all of them
+	RejectProposals(context.Context, *RejectProposalsRequest) (*RejectProposalsResponse, error)
+	// ListProposalHistory returns the approved, rejected, expired and superseded proposals
+	ListProposalHistory(context.Context, *ListProposalHistoryRequest) (*ListProposalHistoryResponse, error)
+	// ListPciHistory returns the PCI changes of a cell, or of all cells, within a time range
+	ListPciHistory(context.Context, *ListPciHistoryRequest) (*ListPciHistoryResponse, error)
+	// GetVerificationStats returns the numbers of PCI changes pending, confirmed, reverted and timed out
+	// in the verification against the next indication message of their cell
+	GetVerificationStats(context.Context, *GetVerificationStatsRequest) (*GetVerificationStatsResponse, error)
+	// ListFrozenCells lists the cells frozen after their PCI oscillated since onos-pci started; frozen cells are
+	// not persisted
+	ListFrozenCells(context.Context, *ListFrozenCellsRequest) (*ListFrozenCellsResponse, error)
+	// UnfreezeCell lets the controller change the PCI of a frozen cell again
+	UnfreezeCell(context.Context, *UnfreezeCellRequest) (*UnfreezeCellResponse, error)
+	// WatchCells streams the existing cells, then their additions, PCI changes, updates and removals
+	WatchCells(*WatchCellsRequest, PciAdmin_WatchCellsServer) error
+	// WatchConflicts streams the existing PCI conflicts, then the conflicts as they are detected and resolved
+	WatchConflicts(*WatchConflictsRequest, PciAdmin_WatchConflictsServer) error
+}
+
+// UnimplementedPciAdminServer should be embedded to have forward compatible implementations.
+type UnimplementedPciAdminServer struct {
+}
+
+func (UnimplementedPciAdminServer) ListConflicts(context.Context, *ListConflictsRequest) (*ListConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConflicts not implemented")
+}
+func (UnimplementedPciAdminServer) ListReservedPcis(context.Context, *ListReservedPcisRequest) (*ListReservedPcisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReservedPcis not implemented")
+}
+func (UnimplementedPciAdminServer) SetReservedPcis(context.Context, *SetReservedPcisRequest) (*SetReservedPcisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReservedPcis not implemented")
+}
+func (UnimplementedPciAdminServer) LockCell(context.Context, *LockCellRequest) (*LockCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockCell not implemented")
+}
+func (UnimplementedPciAdminServer) UnlockCell(context.Context, *UnlockCellRequest) (*UnlockCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockCell not implemented")
+}
+func (UnimplementedPciAdminServer) SetPci(context.Context, *SetPciRequest) (*SetPciResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPci not implemented")
+}
+func (UnimplementedPciAdminServer) ListProposals(context.Context, *ListProposalsRequest) (*ListProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProposals not implemented")
+}
+func (UnimplementedPciAdminServer) ApproveProposals(context.Context, *ApproveProposalsRequest) (*ApproveProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveProposals not implemented")
+}
+func (UnimplementedPciAdminServer) RejectProposals(context.Context, *RejectProposalsRequest) (*RejectProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectProposals not implemented")
+}
+func (UnimplementedPciAdminServer) ListProposalHistory(context.Context, *ListProposalHistoryRequest) (*ListProposalHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProposalHistory not implemented")
+}
+func (UnimplementedPciAdminServer) ListPciHistory(context.Context, *ListPciHistoryRequest) (*ListPciHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPciHistory not implemented")
+}
+func (UnimplementedPciAdminServer) GetVerificationStats(context.Context, *GetVerificationStatsRequest) (*GetVerificationStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVerificationStats not implemented")
+}
+func (UnimplementedPciAdminServer) ListFrozenCells(context.Context, *ListFrozenCellsRequest) (*ListFrozenCellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFrozenCells not implemented")
+}
+func (UnimplementedPciAdminServer) UnfreezeCell(context.Context, *UnfreezeCellRequest) (*UnfreezeCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeCell not implemented")
+}
+func (UnimplementedPciAdminServer) WatchCells(*WatchCellsRequest, PciAdmin_WatchCellsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCells not implemented")
+}
+func (UnimplementedPciAdminServer) WatchConflicts(*WatchConflictsRequest, PciAdmin_WatchConflictsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchConflicts not implemented")
+}
+
+// UnsafePciAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PciAdminServer will
+// result in compilation errors.
+type UnsafePciAdminServer interface {
+	mustEmbedUnimplementedPciAdminServer()
+}
+
+func RegisterPciAdminServer(s grpc.ServiceRegistrar, srv PciAdminServer) {
+	s.RegisterService(&PciAdmin_ServiceDesc, srv)
+}
+
+func _PciAdmin_ListConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).ListConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/ListConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).ListConflicts(ctx, req.(*ListConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_ListReservedPcis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReservedPcisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).ListReservedPcis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/ListReservedPcis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).ListReservedPcis(ctx, req.(*ListReservedPcisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_SetReservedPcis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReservedPcisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).SetReservedPcis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/SetReservedPcis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).SetReservedPcis(ctx, req.(*SetReservedPcisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_LockCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).LockCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/LockCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).LockCell(ctx, req.(*LockCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_UnlockCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).UnlockCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/UnlockCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).UnlockCell(ctx, req.(*UnlockCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_SetPci_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPciRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).SetPci(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/SetPci",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).SetPci(ctx, req.(*SetPciRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_ListProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).ListProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/ListProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).ListProposals(ctx, req.(*ListProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_ApproveProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).ApproveProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/ApproveProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).ApproveProposals(ctx, req.(*ApproveProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_RejectProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).RejectProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/RejectProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).RejectProposals(ctx, req.(*RejectProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_ListProposalHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProposalHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).ListProposalHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/ListProposalHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).ListProposalHistory(ctx, req.(*ListProposalHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_ListPciHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPciHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).ListPciHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/ListPciHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).ListPciHistory(ctx, req.(*ListPciHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_GetVerificationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVerificationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).GetVerificationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/GetVerificationStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).GetVerificationStats(ctx, req.(*GetVerificationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_ListFrozenCells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFrozenCellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).ListFrozenCells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/ListFrozenCells",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).ListFrozenCells(ctx, req.(*ListFrozenCellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_UnfreezeCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PciAdminServer).UnfreezeCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onos.pci.admin.PciAdmin/UnfreezeCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PciAdminServer).UnfreezeCell(ctx, req.(*UnfreezeCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PciAdmin_WatchCells_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCellsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PciAdminServer).WatchCells(m, &pciAdminWatchCellsServer{stream})
+}
+
+type PciAdmin_WatchCellsServer interface {
+	Send(*WatchCellsResponse) error
+	grpc.ServerStream
+}
+
+type pciAdminWatchCellsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pciAdminWatchCellsServer) Send(m *WatchCellsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PciAdmin_WatchConflicts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchConflictsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PciAdminServer).WatchConflicts(m, &pciAdminWatchConflictsServer{stream})
+}
+
+type PciAdmin_WatchConflictsServer interface {
+	Send(*WatchConflictsResponse) error
+	grpc.ServerStream
+}
+
+type pciAdminWatchConflictsServer struct {
+	grpc.ServerStream
+}
+
+func (x *pciAdminWatchConflictsServer) Send(m *WatchConflictsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// PciAdmin_ServiceDesc is the grpc.ServiceDesc for PciAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PciAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "onos.pci.admin.PciAdmin",
+	HandlerType: (*PciAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListConflicts",
+			Handler:    _PciAdmin_ListConflicts_Handler,
+		},
+		{
+			MethodName: "ListReservedPcis",
+			Handler:    _PciAdmin_ListReservedPcis_Handler,
+		},
+		{
+			MethodName: "SetReservedPcis",
+			Handler:    _PciAdmin_SetReservedPcis_Handler,
+		},
+		{
+			MethodName: "LockCell",
+			Handler:    _PciAdmin_LockCell_Handler,
+		},
+		{
+			MethodName: "UnlockCell",
+			Handler:    _PciAdmin_UnlockCell_Handler,
+		},
+		{
+			MethodName: "SetPci",
+			Handler:    _PciAdmin_SetPci_Handler,
+		},
+		{
+			MethodName: "ListProposals",
+			Handler:    _PciAdmin_ListProposals_Handler,
+		},
+		{
+			MethodName: "ApproveProposals",
+			Handler:    _PciAdmin_ApproveProposals_Handler,
+		},
+		{
+			MethodName: "RejectProposals",
+			Handler:    _PciAdmin_RejectProposals_Handler,
+		},
+		{
+			MethodName: "ListProposalHistory",
+			Handler:    _PciAdmin_ListProposalHistory_Handler,
+		},
+		{
+			MethodName: "ListPciHistory",
+			Handler:    _PciAdmin_ListPciHistory_Handler,
+		},
+		{
+			MethodName: "GetVerificationStats",
+			Handler:    _PciAdmin_GetVerificationStats_Handler,
+		},
+		{
+			MethodName: "ListFrozenCells",
+			Handler:    _PciAdmin_ListFrozenCells_Handler,
+		},
+		{
+			MethodName: "UnfreezeCell",
+			Handler:    _PciAdmin_UnfreezeCell_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCells",
+			Handler:       _PciAdmin_WatchCells_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchConflicts",
+			Handler:       _PciAdmin_WatchConflicts_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api/admin.proto",
+}
@@ -0,0 +1,13 @@
+#!/bin/sh
+
+# SPDX-FileCopyrightText: 2022-present Intel Corporation
+# SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+#
+# SPDX-License-Identifier: Apache-2.0
+
+proto_imports=".:${GOPATH}/src/github.com/google/protobuf/src:${GOPATH}/src"
+
+protoc -I=$proto_imports \
+  --go_out=paths=source_relative:. \
+  --go-grpc_out=require_unimplemented_servers=false,paths=source_relative:. \
+  api/*.proto
@@ -7,6 +7,10 @@ package main
 
 import (
 	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/onosproject/onos-lib-go/pkg/certs"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
@@ -24,8 +28,8 @@ func main() {
 	grpcPort := flag.Int("grpcPort", 5150, "grpc Port number")
 	smName := flag.String("smName", "oran-e2sm-rc", "Service model name in RAN function description")
 	smVersion := flag.String("smVersion", "v1", "Service model version in RAN function description")
-
-	ready := make(chan bool)
+	storePath := flag.String("storePath", "", "path to the metrics store file; the metrics are only kept in memory if empty")
+	storeReloadTTL := flag.Int("storeReloadTTL", 600, "seconds within which the cells reloaded from the metrics store file have to send an indication message before they are removed")
 
 	flag.Parse()
 
@@ -37,17 +41,24 @@ func main() {
 	log.Info("Starting onos-pci")
 
 	cfg := manager.Config{
-		CAPath:      *caPath,
-		KeyPath:     *keyPath,
-		CertPath:    *certPath,
-		ConfigPath:  *configPath,
-		E2tEndpoint: *e2tEndpoint,
-		GRPCPort:    *grpcPort,
-		SMName:      *smName,
-		SMVersion:   *smVersion,
+		CAPath:         *caPath,
+		KeyPath:        *keyPath,
+		CertPath:       *certPath,
+		ConfigPath:     *configPath,
+		E2tEndpoint:    *e2tEndpoint,
+		GRPCPort:       *grpcPort,
+		SMName:         *smName,
+		SMVersion:      *smVersion,
+		StorePath:      *storePath,
+		StoreReloadTTL: time.Duration(*storeReloadTTL) * time.Second,
 	}
 
 	mgr := manager.NewManager(cfg)
 	mgr.Run()
-	<-ready
+
+	// the manager is closed on termination, e.g., to close the metrics store file
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
+	<-sigCh
+	mgr.Close()
 }
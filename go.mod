@@ -12,6 +12,7 @@ require (
 	github.com/onosproject/onos-ric-sdk-go v0.8.12
 	github.com/onosproject/onos-test v0.6.5
 	github.com/stretchr/testify v1.8.2
+	go.etcd.io/bbolt v1.3.6
 	google.golang.org/grpc v1.54.0
 	google.golang.org/protobuf v1.28.1
 )
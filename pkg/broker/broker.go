@@ -96,16 +96,17 @@ func (b *streamBroker) CloseStream(ctx context.Context, id e2api.ChannelID) (Str
 	}
 
 	log.Debugf("Deleting Subscription: %s", stream.SubscriptionName())
-	err := stream.Node().Unsubscribe(ctx, stream.SubscriptionName())
-	if err != nil {
-		return nil, err
-	}
+	// the stream is closed even if unsubscribing fails, e.g., since the E2 node is already disconnected
+	unsubscribeErr := stream.Node().Unsubscribe(ctx, stream.SubscriptionName())
 
 	delete(b.subs, stream.ChannelID())
 	delete(b.streams, stream.StreamID())
 
 	log.Infof("Closed stream %d for subscription '%s'", stream.StreamID(), id)
-	return stream, stream.Close()
+	if err := stream.Close(); err != nil {
+		return stream, err
+	}
+	return stream, unsubscribeErr
 }
 
 func (b *streamBroker) GetWriter(id StreamID) (StreamWriter, error) {
@@ -6,12 +6,17 @@ package config
 
 import (
 	"context"
+	"math"
 	"os"
+	"strconv"
 
 	"github.com/onosproject/onos-ric-sdk-go/pkg/config/event"
 	configurable "github.com/onosproject/onos-ric-sdk-go/pkg/config/registry"
 
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/onosproject/onos-pci/pkg/types"
 	"github.com/onosproject/onos-pci/pkg/utils"
 	app "github.com/onosproject/onos-ric-sdk-go/pkg/config/app/default"
 	configutils "github.com/onosproject/onos-ric-sdk-go/pkg/config/utils"
@@ -26,6 +31,13 @@ type Config interface {
 	GetReportPeriodWithPath(path string) (uint64, error)
 	GetReportPeriod() (uint64, error)
 	GetGranularityPeriod() (uint64, error)
+	GetModRules(path string) ([]types.ModRule, error)
+	GetBandwidths(path string) (types.Bandwidths, error)
+	GetPCIPoolConfig(path string) (types.PCIPoolConfig, error)
+	GetReservedPCIConfig(path string) (types.ReservedPCIConfig, error)
+	GetClusters(path string) (map[topoapi.ID]string, error)
+	GetStringWithPath(path string) (string, error)
+	GetUint64WithPath(path string) (uint64, error)
 	Watch(context.Context, chan event.Event) error
 }
 
@@ -94,4 +106,331 @@ func (c *AppConfig) GetGranularityPeriod() (uint64, error) {
 	return val, nil
 }
 
+// GetStringWithPath gets a string value with a given path
+func (c *AppConfig) GetStringWithPath(path string) (string, error) {
+	entry, err := c.appConfig.Get(path)
+	if err != nil {
+		return "", err
+	}
+	return configutils.ToString(entry.Value)
+}
+
+// GetUint64WithPath gets an unsigned integer value with a given path
+func (c *AppConfig) GetUint64WithPath(path string) (uint64, error) {
+	entry, err := c.appConfig.Get(path)
+	if err != nil {
+		return 0, err
+	}
+	return configutils.ToUint64(entry.Value)
+}
+
+// GetBoolWithPath gets a boolean value with a given path
+func (c *AppConfig) GetBoolWithPath(path string) (bool, error) {
+	entry, err := c.appConfig.Get(path)
+	if err != nil {
+		return false, err
+	}
+	val, ok := entry.Value.(bool)
+	if !ok {
+		return false, errors.NewInvalid("%v should be a boolean", path)
+	}
+	return val, nil
+}
+
+// GetModRules gets the PCI modulo rules with a given path
+func (c *AppConfig) GetModRules(path string) ([]types.ModRule, error) {
+	entry, err := c.appConfig.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := entry.Value.([]interface{})
+	if !ok {
+		return nil, errors.NewInvalid("mod rules in %s should be a list", path)
+	}
+
+	rules := make([]types.ModRule, 0, len(list))
+	for _, item := range list {
+		rule, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.NewInvalid("mod rule %v in %s should be an object", item, path)
+		}
+		modulus, err := configutils.ToUint64(rule["modulus"])
+		if err != nil {
+			return nil, err
+		}
+		if modulus == 0 {
+			return nil, errors.NewInvalid("modulus in %s should be greater than 0", path)
+		}
+		weight := uint64(1)
+		if _, ok := rule["weight"]; ok {
+			weight, err = configutils.ToUint64(rule["weight"])
+			if err != nil {
+				return nil, err
+			}
+		}
+		rules = append(rules, types.ModRule{
+			Modulus: int32(modulus),
+			Weight:  int32(weight),
+		})
+	}
+	return rules, nil
+}
+
+// GetBandwidths gets the channel bandwidths with a given path; bandwidths are configured in MHz
+func (c *AppConfig) GetBandwidths(path string) (types.Bandwidths, error) {
+	bandwidths := types.Bandwidths{
+		ARFCNs: make(map[int32]uint32),
+	}
+	entry, err := c.appConfig.Get(path)
+	if err != nil {
+		return bandwidths, err
+	}
+	value, ok := entry.Value.(map[string]interface{})
+	if !ok {
+		return bandwidths, errors.NewInvalid("bandwidths in %s should be an object", path)
+	}
+
+	if _, ok := value["default"]; ok {
+		bandwidths.Default, err = mhzToKhz(value["default"])
+		if err != nil {
+			return bandwidths, err
+		}
+	}
+	if _, ok := value["arfcn"]; ok {
+		arfcns, ok := value["arfcn"].(map[string]interface{})
+		if !ok {
+			return bandwidths, errors.NewInvalid("ARFCN bandwidths in %s should be an object", path)
+		}
+		for key, bandwidth := range arfcns {
+			arfcn, err := strconv.ParseInt(key, 10, 32)
+			if err != nil {
+				return bandwidths, errors.NewInvalid("ARFCN %s in %s should be a number", key, path)
+			}
+			bandwidths.ARFCNs[int32(arfcn)], err = mhzToKhz(bandwidth)
+			if err != nil {
+				return bandwidths, err
+			}
+		}
+	}
+	return bandwidths, nil
+}
+
+// GetPCIPoolConfig gets the PCI pool configuration with a given path
+func (c *AppConfig) GetPCIPoolConfig(path string) (types.PCIPoolConfig, error) {
+	poolConfig := types.PCIPoolConfig{
+		Nodes:  make(map[topoapi.ID][]*types.PCIPool),
+		Cells:  make(map[uint64][]*types.PCIPool),
+		ARFCNs: make(map[int32][]*types.PCIPool),
+	}
+	entry, err := c.appConfig.Get(path)
+	if err != nil {
+		return poolConfig, err
+	}
+	value, ok := entry.Value.(map[string]interface{})
+	if !ok {
+		return poolConfig, errors.NewInvalid("PCI pools in %s should be an object", path)
+	}
+
+	if defaults, ok := value["default"]; ok {
+		rats, ok := defaults.(map[string]interface{})
+		if !ok {
+			return poolConfig, errors.NewInvalid("default PCI pools in %s should be an object", path)
+		}
+		if nr, ok := rats["nr"]; ok {
+			if poolConfig.NR, err = toPCIPools(nr); err != nil {
+				return poolConfig, err
+			}
+		}
+		if eutra, ok := rats["eutra"]; ok {
+			if poolConfig.EUTRA, err = toPCIPools(eutra); err != nil {
+				return poolConfig, err
+			}
+		}
+	}
+	nodes, err := toObject(value["nodes"])
+	if err != nil {
+		return poolConfig, err
+	}
+	for nodeID, pools := range nodes {
+		if poolConfig.Nodes[topoapi.ID(nodeID)], err = toPCIPools(pools); err != nil {
+			return poolConfig, err
+		}
+	}
+	cells, err := toObject(value["cells"])
+	if err != nil {
+		return poolConfig, err
+	}
+	for key, pools := range cells {
+		cellID, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return poolConfig, errors.NewInvalid("cell ID %s in %s should be a number", key, path)
+		}
+		if poolConfig.Cells[cellID], err = toPCIPools(pools); err != nil {
+			return poolConfig, err
+		}
+	}
+	arfcns, err := toObject(value["arfcns"])
+	if err != nil {
+		return poolConfig, err
+	}
+	for key, pools := range arfcns {
+		arfcn, err := strconv.ParseInt(key, 10, 32)
+		if err != nil {
+			return poolConfig, errors.NewInvalid("ARFCN %s in %s should be a number", key, path)
+		}
+		if poolConfig.ARFCNs[int32(arfcn)], err = toPCIPools(pools); err != nil {
+			return poolConfig, err
+		}
+	}
+	if rnib, ok := value["rnib"]; ok {
+		poolConfig.RNIB, ok = rnib.(bool)
+		if !ok {
+			return poolConfig, errors.NewInvalid("rnib in %s should be a boolean", path)
+		}
+	}
+	return poolConfig, nil
+}
+
+// GetReservedPCIConfig gets the reserved PCI configuration with a given path
+func (c *AppConfig) GetReservedPCIConfig(path string) (types.ReservedPCIConfig, error) {
+	reservedConfig := types.ReservedPCIConfig{
+		PLMNs:    make(map[uint32][]int32),
+		Clusters: make(map[string][]int32),
+	}
+	entry, err := c.appConfig.Get(path)
+	if err != nil {
+		return reservedConfig, err
+	}
+	value, ok := entry.Value.(map[string]interface{})
+	if !ok {
+		return reservedConfig, errors.NewInvalid("reserved PCIs in %s should be an object", path)
+	}
+
+	if global, ok := value["global"]; ok {
+		if reservedConfig.Global, err = toPCIs(global); err != nil {
+			return reservedConfig, err
+		}
+	}
+	plmns, err := toObject(value["plmns"])
+	if err != nil {
+		return reservedConfig, err
+	}
+	for key, pcis := range plmns {
+		// PLMN IDs are in hex as in R-NIB, e.g., 138426
+		plmnID, err := strconv.ParseUint(key, 16, 32)
+		if err != nil {
+			return reservedConfig, errors.NewInvalid("PLMN ID %s in %s should be a hex number", key, path)
+		}
+		if reservedConfig.PLMNs[uint32(plmnID)], err = toPCIs(pcis); err != nil {
+			return reservedConfig, err
+		}
+	}
+	clusters, err := toObject(value["clusters"])
+	if err != nil {
+		return reservedConfig, err
+	}
+	for cluster, pcis := range clusters {
+		if reservedConfig.Clusters[cluster], err = toPCIs(pcis); err != nil {
+			return reservedConfig, err
+		}
+	}
+	return reservedConfig, nil
+}
+
+// GetClusters gets the geographic cluster of each E2 node with a given path
+func (c *AppConfig) GetClusters(path string) (map[topoapi.ID]string, error) {
+	entry, err := c.appConfig.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := toObject(entry.Value)
+	if err != nil {
+		return nil, err
+	}
+	nodeClusters := make(map[topoapi.ID]string)
+	for cluster, value := range clusters {
+		nodeIDs, ok := value.([]interface{})
+		if !ok {
+			return nil, errors.NewInvalid("E2 nodes of cluster %s in %s should be a list", cluster, path)
+		}
+		for _, nodeID := range nodeIDs {
+			id, err := configutils.ToString(nodeID)
+			if err != nil {
+				return nil, err
+			}
+			if other, ok := nodeClusters[topoapi.ID(id)]; ok {
+				return nil, errors.NewInvalid("E2 node %s in %s is in both clusters %s and %s", id, path, other, cluster)
+			}
+			nodeClusters[topoapi.ID(id)] = cluster
+		}
+	}
+	return nodeClusters, nil
+}
+
+// toPCIs converts a list of PCIs
+func toPCIs(value interface{}) ([]int32, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.NewInvalid("PCIs %v should be a list", value)
+	}
+	pcis := make([]int32, 0, len(list))
+	for _, item := range list {
+		pci, err := configutils.ToUint64(item)
+		if err != nil {
+			return nil, err
+		}
+		pcis = append(pcis, int32(pci))
+	}
+	return pcis, nil
+}
+
+// toObject converts an optional JSON object
+func toObject(value interface{}) (map[string]interface{}, error) {
+	if value == nil {
+		return map[string]interface{}{}, nil
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.NewInvalid("%v should be an object", value)
+	}
+	return object, nil
+}
+
+// toPCIPools converts a list of PCI ranges, e.g., [{"lower": 1, "upper": 100}]
+func toPCIPools(value interface{}) ([]*types.PCIPool, error) {
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil, errors.NewInvalid("PCI pools %v should be a list", value)
+	}
+	pools := make([]*types.PCIPool, 0, len(list))
+	for _, item := range list {
+		pciRange, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.NewInvalid("PCI range %v should be an object", item)
+		}
+		lower, err := configutils.ToUint64(pciRange["lower"])
+		if err != nil {
+			return nil, err
+		}
+		upper, err := configutils.ToUint64(pciRange["upper"])
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, &types.PCIPool{
+			LowerPci: int32(lower),
+			UpperPci: int32(upper),
+		})
+	}
+	return pools, nil
+}
+
+// mhzToKhz converts a bandwidth in MHz, e.g., 1.4 MHz for EUTRA, to kHz
+func mhzToKhz(value interface{}) (uint32, error) {
+	mhz, ok := value.(float64)
+	if !ok || mhz <= 0 {
+		return 0, errors.NewInvalid("bandwidth %v should be a positive number of MHz", value)
+	}
+	return uint32(math.Round(mhz * 1000)), nil
+}
+
 var _ Config = &AppConfig{}
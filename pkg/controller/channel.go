@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"github.com/onosproject/onos-pci/pkg/utils/arfcn"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+)
+
+// isCoChannel checks if the PCIs of two cells can conflict: NR and EUTRA PCIs are separate spaces,
+// and cells of the same RAT conflict if their carriers overlap, even partially
+func (p *PciController) isCoChannel(aType parse.CGIType, aArfcn int32, bType parse.CGIType, bArfcn int32) bool {
+	if aType != bType {
+		return false
+	}
+	if aArfcn == bArfcn {
+		return true
+	}
+	aFreq, err := p.getFrequency(aType, aArfcn)
+	if err != nil {
+		log.Debug(err)
+		return false
+	}
+	bFreq, err := p.getFrequency(bType, bArfcn)
+	if err != nil {
+		log.Debug(err)
+		return false
+	}
+	return arfcn.Overlaps(aFreq, p.getBandwidth(aType, aArfcn), bFreq, p.getBandwidth(bType, bArfcn))
+}
+
+// getFrequency returns the center frequency in kHz of the carrier of a cell
+func (p *PciController) getFrequency(cgiType parse.CGIType, a int32) (int64, error) {
+	if cgiType == parse.CGITypeECGI {
+		return arfcn.EUTRAFrequency(a)
+	}
+	return arfcn.NRFrequency(a)
+}
+
+// getBandwidth returns the channel bandwidth in kHz of the carrier of a cell
+func (p *PciController) getBandwidth(cgiType parse.CGIType, a int32) uint32 {
+	bandwidths := p.bandwidths[cgiType]
+	if bandwidth, ok := bandwidths.ARFCNs[a]; ok {
+		return bandwidth
+	}
+	return bandwidths.Default
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsCoChannel(t *testing.T) {
+	nr := parse.CGITypeNrCGI
+	eutra := parse.CGITypeECGI
+
+	// without bandwidths, only the same ARFCN overlaps
+	pciCtrl := NewPciController(metrics.NewStore())
+	assert.True(t, pciCtrl.isCoChannel(nr, 632628, nr, 632628))
+	assert.False(t, pciCtrl.isCoChannel(nr, 632628, nr, 636000))
+	assert.False(t, pciCtrl.isCoChannel(nr, 1575, eutra, 1575))
+
+	pciCtrl = NewPciController(metrics.NewStore(),
+		WithBandwidths(nr, types.Bandwidths{Default: 20000, ARFCNs: map[int32]uint32{632628: 100000}}),
+		WithBandwidths(eutra, types.Bandwidths{Default: 20000}))
+	// 3489.42 MHz with 100 MHz and 3540 MHz with 20 MHz partially overlap
+	assert.True(t, pciCtrl.isCoChannel(nr, 632628, nr, 636000))
+	// 3540 MHz and 3560.01 MHz with 20 MHz do not overlap
+	assert.False(t, pciCtrl.isCoChannel(nr, 636000, nr, 637334))
+	// 1842.5 MHz and 1850 MHz with 20 MHz overlap
+	assert.True(t, pciCtrl.isCoChannel(eutra, 1575, eutra, 1650))
+	// unknown EARFCNs only overlap with the same EARFCN
+	assert.False(t, pciCtrl.isCoChannel(eutra, 18000, eutra, 18001))
+}
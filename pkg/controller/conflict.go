@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+)
+
+// ConflictType is the type of PCI conflict
+type ConflictType int
+
+const (
+	// Collision is a cell sharing its PCI with one of its neighbors
+	Collision ConflictType = iota
+	// Confusion is two neighbors of the same cell sharing a PCI
+	Confusion
+)
+
+func (c ConflictType) String() string {
+	return [...]string{"Collision", "Confusion"}[c]
+}
+
+// Conflict is a PCI conflict between a pair of cells
+type Conflict struct {
+	Type ConflictType
+	// CellID and PeerID are the keys of the conflicting cells
+	CellID uint64
+	PeerID uint64
+	// CommonNeighborID is the key of the cell both conflicting cells are neighbors of; only set for Confusion
+	CommonNeighborID uint64
+	PCI              int32
+	ARFCN            int32
+}
+
+// Involves checks if a cell is one of the conflicting cells
+func (c Conflict) Involves(key uint64) bool {
+	return c.CellID == key || c.PeerID == key
+}
+
+// ID returns a key identifying the conflict regardless of the order of the conflicting cells
+func (c Conflict) ID() Conflict {
+	if c.CellID > c.PeerID {
+		c.CellID, c.PeerID = c.PeerID, c.CellID
+	}
+	return c
+}
+
+// neighborCell is a neighbor cell with its most recent PCI
+type neighborCell struct {
+	key     uint64
+	cgi     *e2smrccomm.Cgi
+	cgiType parse.CGIType
+	pci     int32
+	arfcn   int32
+	// entry is the neighbor's entry in store; nil if the neighbor is not connected to E2 nodes subscribed by this app
+	entry *metrics.Entry
+}
+
+// getNeighbors returns the neighbors of an entry; the PCI in store is preferred to the one in the neighbor list
+// because this controller updates PCI in store after sending RC-PRE control message
+func (p *PciController) getNeighbors(ctx context.Context, entry *metrics.Entry) []neighborCell {
+	neighbors := make([]neighborCell, 0, len(entry.Value.Neighbors))
+	for _, n := range entry.Value.Neighbors {
+		cgi, pci, arfcn, err := parse.GetNeighborInfo(n)
+		if err != nil {
+			log.Errorf("Neighbor type should be NR or EUTRAN: %v", n)
+			continue
+		}
+		if p.isCGIEqual(entry.Key.CellGlobalID, cgi) {
+			continue
+		}
+		neighbor := neighborCell{
+			key:     metrics.NewKey(cgi),
+			cgi:     cgi,
+			cgiType: parse.GetCGIType(cgi),
+			pci:     pci,
+			arfcn:   arfcn,
+		}
+		if neighborEntry := p.getEntryWithNeighborCGI(ctx, cgi); neighborEntry != nil {
+			if p.isIgnored(neighborEntry) {
+				continue
+			}
+			neighbor.entry = neighborEntry
+			neighbor.pci = neighborEntry.Value.Metric.PCI
+		}
+		neighbors = append(neighbors, neighbor)
+	}
+	return neighbors
+}
+
+// getCollisions returns the collisions between an entry and its neighbors
+func (p *PciController) getCollisions(entry *metrics.Entry, neighbors []neighborCell) []Conflict {
+	conflicts := make([]Conflict, 0)
+	key := metrics.NewKey(entry.Key.CellGlobalID)
+	cgiType := parse.GetCGIType(entry.Key.CellGlobalID)
+	for _, n := range neighbors {
+		if n.pci == entry.Value.Metric.PCI && p.isCoChannel(cgiType, entry.Value.Metric.ARFCN, n.cgiType, n.arfcn) {
+			conflicts = append(conflicts, Conflict{
+				Type:   Collision,
+				CellID: key,
+				PeerID: n.key,
+				PCI:    n.pci,
+				ARFCN:  n.arfcn,
+			})
+		}
+	}
+	return conflicts
+}
+
+// getConfusions returns the confusions among the neighbors of an entry
+func (p *PciController) getConfusions(entry *metrics.Entry, neighbors []neighborCell) []Conflict {
+	conflicts := make([]Conflict, 0)
+	key := metrics.NewKey(entry.Key.CellGlobalID)
+	for i := 0; i < len(neighbors); i++ {
+		for j := i + 1; j < len(neighbors); j++ {
+			a, b := neighbors[i], neighbors[j]
+			if a.key == b.key {
+				continue
+			}
+			if a.pci == b.pci && p.isCoChannel(a.cgiType, a.arfcn, b.cgiType, b.arfcn) {
+				conflicts = append(conflicts, Conflict{
+					Type:             Confusion,
+					CellID:           a.key,
+					PeerID:           b.key,
+					CommonNeighborID: key,
+					PCI:              a.pci,
+					ARFCN:            a.arfcn,
+				})
+			}
+		}
+	}
+	return conflicts
+}
+
+// DetectConflicts returns the collisions and confusions a cell is involved in,
+// either as one of the conflicting cells or as the common neighbor of confused cells
+func (p *PciController) DetectConflicts(ctx context.Context, entry *metrics.Entry) []Conflict {
+	key := metrics.NewKey(entry.Key.CellGlobalID)
+	neighbors := p.getNeighbors(ctx, entry)
+	conflicts := make([]Conflict, 0)
+	conflicts = append(conflicts, p.getCollisions(entry, neighbors)...)
+	conflicts = append(conflicts, p.getConfusions(entry, neighbors)...)
+	// the cell is confused with another neighbor of each cell it is a neighbor of;
+	// the neighbor lists may be asymmetric, so the cells listing it are checked too
+	neighborKeys, err := p.metricStore.GetNeighbors(ctx, key)
+	if err != nil {
+		log.Warn(err)
+	}
+	for _, k := range neighborKeys {
+		n, err := p.metricStore.Get(ctx, k)
+		if err != nil || p.isIgnored(n) {
+			continue
+		}
+		for _, c := range p.getConfusions(n, p.getNeighbors(ctx, n)) {
+			if c.Involves(key) {
+				conflicts = append(conflicts, c)
+			}
+		}
+	}
+	return dedupConflicts(conflicts)
+}
+
+// DetectAllConflicts returns the collisions and confusions of all cells in store
+func (p *PciController) DetectAllConflicts(ctx context.Context) ([]Conflict, error) {
+	entries, err := p.listEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conflicts := make([]Conflict, 0)
+	for _, entry := range entries {
+		neighbors := p.getNeighbors(ctx, entry)
+		conflicts = append(conflicts, p.getCollisions(entry, neighbors)...)
+		conflicts = append(conflicts, p.getConfusions(entry, neighbors)...)
+	}
+	return dedupConflicts(conflicts), nil
+}
+
+// listEntries collects all entries in store, so that the store is not locked while they are processed
+func (p *PciController) listEntries(ctx context.Context) ([]*metrics.Entry, error) {
+	ch := make(chan *metrics.Entry, 1024)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- p.metricStore.Entries(ctx, ch)
+	}()
+	entries := make([]*metrics.Entry, 0)
+	for entry := range ch {
+		entries = append(entries, entry)
+	}
+	if err := <-errCh; err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// dedupConflicts removes the duplicated conflicts, e.g., a collision found from both sides
+func dedupConflicts(conflicts []Conflict) []Conflict {
+	seen := make(map[Conflict]bool)
+	out := make([]Conflict, 0, len(conflicts))
+	for _, c := range conflicts {
+		if seen[c.ID()] {
+			continue
+		}
+		seen[c.ID()] = true
+		out = append(out, c)
+	}
+	return out
+}
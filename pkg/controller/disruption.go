@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sort"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/store/history"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+)
+
+// disruption is the cost of changing the PCI of a cell: a locked or frozen cell cannot be changed at all, renumbering a cell
+// with more neighbors, e.g., a macro cell, drops or re-establishes more neighbor relations, and a cell changed many
+// times should be left alone. The E2 nodes do not report the cell load with the RC indication messages,
+// so it is not part of the cost.
+type disruption struct {
+	locked            bool
+	neighbors         int
+	resolvedConflicts uint32
+	key               uint64
+}
+
+func newDisruption(entry *metrics.Entry, frozen bool) disruption {
+	return disruption{
+		locked:            entry.Value.Locked || frozen,
+		neighbors:         len(entry.Value.Neighbors),
+		resolvedConflicts: entry.Value.Metric.ResolvedConflicts,
+		key:               metrics.NewKey(entry.Key.CellGlobalID),
+	}
+}
+
+// less compares the costs by the lock, the number of neighbors, then the number of prior PCI changes;
+// ties are broken by the lowest key so that the same cell is picked from any side of a conflict
+func (d disruption) less(o disruption) bool {
+	if d.locked != o.locked {
+		return !d.locked
+	}
+	if d.neighbors != o.neighbors {
+		return d.neighbors < o.neighbors
+	}
+	if d.resolvedConflicts != o.resolvedConflicts {
+		return d.resolvedConflicts < o.resolvedConflicts
+	}
+	return d.key < o.key
+}
+
+// sortByDisruption sorts the entries from the least to the most disruptive to change
+func (p *PciController) sortByDisruption(entries []*metrics.Entry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return p.newDisruption(entries[i]).less(p.newDisruption(entries[j]))
+	})
+}
+
+func (p *PciController) newDisruption(entry *metrics.Entry) disruption {
+	return newDisruption(entry, p.stabilizer.isFrozen(metrics.NewKey(entry.Key.CellGlobalID)))
+}
+
+// getConflictingEntries returns the entry and the cells in store it conflicts with,
+// i.e., all sides of its conflicts whose PCI can be changed by this app
+func (p *PciController) getConflictingEntries(ctx context.Context, entry *metrics.Entry) []*metrics.Entry {
+	key := metrics.NewKey(entry.Key.CellGlobalID)
+	entries := []*metrics.Entry{entry}
+	added := map[uint64]bool{key: true}
+	for _, c := range p.DetectConflicts(ctx, entry) {
+		if !c.Involves(key) {
+			continue
+		}
+		peer := c.PeerID
+		if peer == key {
+			peer = c.CellID
+		}
+		if added[peer] {
+			continue
+		}
+		peerEntry, err := p.metricStore.Get(ctx, peer)
+		if err != nil {
+			// the peer is not connected to E2 nodes subscribed by this app, so only this side can be changed
+			continue
+		}
+		added[peer] = true
+		entries = append(entries, peerEntry)
+	}
+	return entries
+}
+
+// changeLeastDisruptive changes the PCI of the least disruptive entry among all sides of a conflict;
+// if an entry does not see the conflict from its side, e.g., due to asymmetric neighbor lists, the next one is tried.
+// Locked and frozen entries are only tried last, so that the conflict is resolved by changing the other side only.
+func (p *PciController) changeLeastDisruptive(ctx context.Context, entries []*metrics.Entry) error {
+	p.sortByDisruption(entries)
+	keys := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, metrics.NewKey(entry.Key.CellGlobalID))
+	}
+	var err error
+	for _, entry := range entries {
+		pci, changed, pciErr := p.getAvailablePci(ctx, entry)
+		if pciErr != nil {
+			log.Debugf("skip pci logic for %v due to %v", entry.Key, pciErr)
+			err = pciErr
+			continue
+		}
+		if !changed {
+			continue
+		}
+		log.Debugf("NewPCI for %v: %v", entry.Key, pci)
+		key := metrics.NewKey(entry.Key.CellGlobalID)
+		conflicting := make([]uint64, 0, len(keys))
+		for _, k := range keys {
+			if k != key {
+				conflicting = append(conflicting, k)
+			}
+		}
+		err = p.changePci(ctx, key, pci, history.Conflict, conflicting)
+		if err != nil {
+			log.Error(err)
+		}
+		return err
+	}
+
+	if errors.IsConflict(err) && len(entries) > 1 && p.newDisruption(entries[0]).locked {
+		return errors.NewConflict("PCI conflict cannot be resolved since all cells %v are locked or frozen", keys)
+	}
+	return err
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeLeastDisruptive(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	// the macro cell 1 collides with the small cell 2
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2, 3, 4}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+		{nci: 3, arfcn: 100, pci: 2, neighbors: []byte{1}},
+		{nci: 4, arfcn: 100, pci: 3, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store)
+
+	entries, err := pciCtrl.listEntries(ctx)
+	assert.NoError(t, err)
+	cells := make(map[uint64]*metrics.Entry)
+	for _, entry := range entries {
+		cells[metrics.NewKey(entry.Key.CellGlobalID)] = entry
+	}
+	macro := cells[metrics.NewKey(testCGI(1))]
+	small := cells[metrics.NewKey(testCGI(2))]
+
+	// the indication of the macro cell arrived, but the small cell is changed
+	assert.NoError(t, pciCtrl.changeLeastDisruptive(ctx, pciCtrl.getConflictingEntries(ctx, macro)))
+	macro, small = getTestEntry(t, store, 1), getTestEntry(t, store, 2)
+	assert.Equal(t, int32(1), macro.Value.Metric.PCI)
+	assert.NotEqual(t, int32(1), small.Value.Metric.PCI)
+	assert.Equal(t, uint32(1), small.Value.Metric.ResolvedConflicts)
+
+	conflicts, err := pciCtrl.DetectAllConflicts(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, conflicts)
+}
+
+func TestChangeLeastDisruptiveLocked(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2, 3}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+		{nci: 3, arfcn: 100, pci: 2, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store)
+	macroKey, smallKey := metrics.NewKey(testCGI(1)), metrics.NewKey(testCGI(2))
+	assert.NoError(t, store.SetLocked(ctx, smallKey, true))
+	assert.NoError(t, store.SetLocked(ctx, macroKey, true))
+	macro, err := store.Get(ctx, macroKey)
+	assert.NoError(t, err)
+	small, err := store.Get(ctx, smallKey)
+	assert.NoError(t, err)
+
+	// both sides are locked
+	err = pciCtrl.changeLeastDisruptive(ctx, pciCtrl.getConflictingEntries(ctx, macro))
+	assert.True(t, errors.IsConflict(err))
+	macro, small = getTestEntry(t, store, 1), getTestEntry(t, store, 2)
+	assert.Equal(t, int32(1), macro.Value.Metric.PCI)
+	assert.Equal(t, int32(1), small.Value.Metric.PCI)
+
+	// only the unlocked side is changed, even if it is more disruptive
+	assert.NoError(t, store.SetLocked(ctx, macroKey, false))
+	assert.NoError(t, pciCtrl.changeLeastDisruptive(ctx, pciCtrl.getConflictingEntries(ctx, small)))
+	macro, small = getTestEntry(t, store, 1), getTestEntry(t, store, 2)
+	assert.NotEqual(t, int32(1), macro.Value.Metric.PCI)
+	assert.Equal(t, int32(1), small.Value.Metric.PCI)
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/onosproject/onos-pci/pkg/store/history"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+)
+
+// changeIDs generates the IDs of the PCI changes; they start from the start time, so that they are not reused
+// after a restart
+type changeIDs struct {
+	last uint64
+}
+
+func newChangeIDs() *changeIDs {
+	return &changeIDs{last: uint64(time.Now().UnixNano())}
+}
+
+func (c *changeIDs) next() uint64 {
+	return atomic.AddUint64(&c.last, 1)
+}
+
+// updatePci updates the PCI of a cell in store, so that the E2 manager sends the RC control message,
+// and records the change in the history; all PCI changes go through here. It returns the ID of the change.
+func (p *PciController) updatePci(ctx context.Context, key uint64, pci int32, trigger history.Trigger, actor history.Actor, conflicting []uint64) (uint64, error) {
+	entry, err := p.metricStore.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	record := history.Record{
+		CellID:           key,
+		Time:             time.Now(),
+		OldPCI:           entry.Value.Metric.PCI,
+		NewPCI:           pci,
+		ChangeID:         p.changeIDs.next(),
+		Trigger:          trigger,
+		Actor:            actor,
+		ConflictingCells: conflicting,
+	}
+	// the settle window starts before the change is watched, so that the watchers already see the cell settling
+	undoSettle := func() {}
+	if actor == history.Controller {
+		undoSettle = p.stabilizer.recordChange(key, entry.Value.Cluster, record.Time)
+	}
+	// the change is in the history before it is watched, so that its control outcome always finds it
+	if err := p.historyStore.Add(ctx, record); err != nil {
+		log.Warn(err)
+	}
+	if err := p.metricStore.UpdatePci(ctx, key, pci, record.ChangeID); err != nil {
+		undoSettle()
+		if err := p.historyStore.Remove(ctx, key, record.ChangeID); err != nil {
+			log.Warn(err)
+		}
+		return 0, err
+	}
+	return record.ChangeID, nil
+}
+
+// recordControlOutcomes starts setting the outcomes of the RC control messages to the PCI changes in the history,
+// and records the rollbacks of the changes whose control message finally failed as changes;
+// the store is watched before it returns, so that no outcome is missed
+func (p *PciController) recordControlOutcomes(ctx context.Context) error {
+	ch := make(chan metrics.Event)
+	if err := p.metricStore.Watch(ctx, ch, metrics.WithEventTypes(metrics.ControlResult)); err != nil {
+		return err
+	}
+	go func() {
+		for e := range ch {
+			if e.Type != metrics.ControlResult || e.Value.Value.LastControl == nil {
+				continue
+			}
+			result := *e.Value.Value.LastControl
+			if err := p.historyStore.SetOutcome(ctx, e.Key, result); err != nil {
+				log.Debug(err)
+			}
+			if !result.RolledBack || e.Value.Value.Metric == nil {
+				continue
+			}
+			err := p.historyStore.Add(ctx, history.Record{
+				CellID:   e.Key,
+				Time:     time.Now(),
+				OldPCI:   result.PCI,
+				NewPCI:   e.Value.Value.Metric.PCI,
+				ChangeID: result.ChangeID,
+				Trigger:  history.Rollback,
+				Actor:    history.Controller,
+			})
+			if err != nil {
+				log.Warn(err)
+			}
+		}
+	}()
+	return nil
+}
+
+// ListHistory lists the PCI changes of a cell, or of all cells if key is 0, made within a time range
+func (p *PciController) ListHistory(ctx context.Context, key uint64, start time.Time, end time.Time) ([]*history.Record, error) {
+	return p.historyStore.List(ctx, key, start, end)
+}
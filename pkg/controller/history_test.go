@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-pci/pkg/store/history"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistory(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2, 3}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+		{nci: 3, arfcn: 100, pci: 2, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store)
+	assert.NoError(t, pciCtrl.recordControlOutcomes(ctx))
+	macroKey, smallKey := metrics.NewKey(testCGI(1)), metrics.NewKey(testCGI(2))
+	small, err := store.Get(ctx, smallKey)
+	assert.NoError(t, err)
+
+	start := time.Now()
+	assert.NoError(t, pciCtrl.changeLeastDisruptive(ctx, pciCtrl.getConflictingEntries(ctx, small)))
+	resolvedPci := getTestEntry(t, store, 2).Value.Metric.PCI
+	changeID, err := pciCtrl.SetPci(ctx, macroKey, 5)
+	assert.NoError(t, err)
+
+	records, err := pciCtrl.ListHistory(ctx, 0, start, time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, history.Record{
+		CellID:           smallKey,
+		Time:             records[0].Time,
+		OldPCI:           1,
+		NewPCI:           resolvedPci,
+		ChangeID:         records[0].ChangeID,
+		Trigger:          history.Conflict,
+		Actor:            history.Controller,
+		ConflictingCells: []uint64{macroKey},
+	}, *records[0])
+	assert.Equal(t, macroKey, records[1].CellID)
+	assert.Equal(t, changeID, records[1].ChangeID)
+	assert.NotEqual(t, records[0].ChangeID, changeID)
+	assert.Equal(t, history.Manual, records[1].Trigger)
+	assert.Equal(t, history.Operator, records[1].Actor)
+
+	// the control outcome is attached to the change
+	assert.NoError(t, store.SetControlResult(ctx, macroKey, types.ControlResult{PCI: 5, ChangeID: changeID, Outcome: []byte{1}}))
+	assert.Eventually(t, func() bool {
+		records, err := pciCtrl.ListHistory(ctx, macroKey, time.Time{}, time.Time{})
+		return err == nil && len(records) == 1 && records[0].Outcome != nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func TestHistoryRollback(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 2, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store)
+	assert.NoError(t, pciCtrl.recordControlOutcomes(ctx))
+	key := metrics.NewKey(testCGI(1))
+
+	// the change is in the history as soon as it is made, so that an immediate outcome is attached to it
+	ch := make(chan metrics.Event, 1)
+	assert.NoError(t, store.Watch(ctx, ch, metrics.WithEventTypes(metrics.UpdatedPCI)))
+	changeID, err := pciCtrl.SetPci(ctx, key, 5)
+	assert.NoError(t, err)
+	<-ch
+	records, err := pciCtrl.ListHistory(ctx, key, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+
+	// the rollback after the final failure is recorded as a change
+	assert.NoError(t, store.RollbackPci(ctx, key, types.ControlResult{PCI: 5, ChangeID: changeID, Error: "rejected"}))
+	assert.Eventually(t, func() bool {
+		records, err = pciCtrl.ListHistory(ctx, key, time.Time{}, time.Time{})
+		return err == nil && len(records) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.NotNil(t, records[0].Outcome)
+	assert.True(t, records[0].Outcome.RolledBack)
+	assert.Equal(t, int32(5), records[1].OldPCI)
+	assert.Equal(t, int32(1), records[1].NewPCI)
+	assert.Equal(t, changeID, records[1].ChangeID)
+	assert.Equal(t, history.Rollback, records[1].Trigger)
+	assert.Equal(t, history.Controller, records[1].Actor)
+}
+
+func TestHistoryFailedChange(t *testing.T) {
+	ctx := context.Background()
+	store, err := metrics.NewPersistentStore(filepath.Join(t.TempDir(), "metrics.db"), 0)
+	assert.NoError(t, err)
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store, WithSettleWindows(time.Minute, 0), WithOscillationDetection(1, time.Minute))
+	key := metrics.NewKey(testCGI(1))
+
+	// a change which could not be written leaves neither a history record nor a settle window or an alarm
+	assert.NoError(t, store.Close())
+	assert.Error(t, pciCtrl.changePci(ctx, key, 3, history.Conflict, nil))
+	records, err := pciCtrl.ListHistory(ctx, key, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Empty(t, records)
+	assert.False(t, pciCtrl.stabilizer.settling(key, "", time.Now()))
+	assert.Empty(t, pciCtrl.ListFrozenCells(ctx))
+}
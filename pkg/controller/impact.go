@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+)
+
+// neighborLists keeps the last neighbor list evaluated of each cell, to detect new and removed neighbor relations
+type neighborLists map[uint64]map[uint64]bool
+
+// changed records the neighbor list of an entry and checks if it differs from the previous one
+func (n neighborLists) changed(key uint64, entry metrics.Entry) bool {
+	neighbors := make(map[uint64]bool)
+	for _, item := range entry.Value.Neighbors {
+		cgi, _, _, err := parse.GetNeighborInfo(item)
+		if err != nil {
+			continue
+		}
+		neighbors[metrics.NewKey(cgi)] = true
+	}
+	previous, ok := n[key]
+	n[key] = neighbors
+	if !ok || len(previous) != len(neighbors) {
+		return true
+	}
+	for neighbor := range neighbors {
+		if !previous[neighbor] {
+			return true
+		}
+	}
+	return false
+}
+
+// impactedCells returns the cell, then the cells within the search depth of it in either direction of the neighbor
+// relations, whose collisions and confusions may be changed by a change of the cell
+func (p *PciController) impactedCells(ctx context.Context, key uint64) []uint64 {
+	return p.cellsWithin(ctx, key, p.searchDepth)
+}
+
+// neighborhood returns the cells whose PCIs the work on a cell may read or change. The impacted cells are within
+// the search depth of the cell, and the cells changed to resolve their conflicts up to twice the search depth from
+// it, since a confused peer is two hops from the impacted cell; each change then reads the PCIs within the search
+// depth of the changed cell.
+func (p *PciController) neighborhood(ctx context.Context, key uint64) []uint64 {
+	changed := 2 * p.searchDepth
+	if changed < p.searchDepth+2 {
+		changed = p.searchDepth + 2
+	}
+	return p.cellsWithin(ctx, key, changed+p.searchDepth)
+}
+
+// changeNeighborhood returns the cells whose PCIs a single change of a cell reads or changes: the cell and the
+// cells within the search depth of it
+func (p *PciController) changeNeighborhood(ctx context.Context, key uint64) []uint64 {
+	return p.cellsWithin(ctx, key, p.searchDepth)
+}
+
+// cellsWithin returns the cell, then the cells up to a number of hops from it in either direction of the neighbor
+// relations, ordered by distance
+func (p *PciController) cellsWithin(ctx context.Context, key uint64, hops int) []uint64 {
+	impacted := []uint64{key}
+	visited := map[uint64]bool{key: true}
+	frontier := []uint64{key}
+	for depth := 0; depth < hops && len(frontier) > 0; depth++ {
+		next := make([]uint64, 0)
+		for _, k := range frontier {
+			neighbors, err := p.metricStore.GetNeighbors(ctx, k)
+			if err != nil {
+				continue
+			}
+			for _, neighbor := range neighbors {
+				if visited[neighbor] {
+					continue
+				}
+				visited[neighbor] = true
+				// a stale neighbor does not conflict with the other cells, so the traversal does not go through it
+				if entry, err := p.metricStore.Get(ctx, neighbor); err != nil || p.isIgnored(entry) {
+					continue
+				}
+				impacted = append(impacted, neighbor)
+				next = append(next, neighbor)
+			}
+		}
+		frontier = next
+	}
+	return impacted
+}
+
+// resolveImpacted resolves the conflicts of the cells impacted by the events of a cell: a PCI change or a change
+// of the neighbor list of a cell impacts the cells within the search depth, any other change only the cell itself
+func (p *PciController) resolveImpacted(ctx context.Context, item workItem) {
+	impacted := []uint64{item.key}
+	if item.expand {
+		impacted = p.impactedCells(ctx, item.key)
+	}
+	for _, key := range impacted {
+		// the PCIs may have been changed while resolving the conflicts of an earlier cell
+		entry, err := p.metricStore.Get(ctx, key)
+		if err != nil || p.isIgnored(entry) {
+			continue
+		}
+		// the cell whose change is the least disruptive is changed, not necessarily the impacted one
+		if err := p.changeLeastDisruptive(ctx, p.getConflictingEntries(ctx, entry)); err != nil {
+			log.Errorf("skip pci logic for cell %v impacted by cell %v due to %v", key, item.key, err)
+		}
+		p.resolveConfusions(ctx, entry)
+	}
+}
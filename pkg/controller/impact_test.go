@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImpactedCells(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	// cell 4 lists cell 3, but cell 3 does not list it
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 2, neighbors: []byte{1, 3}},
+		{nci: 3, arfcn: 100, pci: 3, neighbors: []byte{2}},
+		{nci: 4, arfcn: 100, pci: 4, neighbors: []byte{3}},
+	})
+	key := func(nci byte) uint64 {
+		return metrics.NewKey(testCGI(nci))
+	}
+	pciCtrl := NewPciController(store)
+	assert.Equal(t, []uint64{key(1), key(2), key(3)}, pciCtrl.impactedCells(ctx, key(1)))
+	assert.ElementsMatch(t, []uint64{key(1), key(2), key(3), key(4)}, pciCtrl.impactedCells(ctx, key(2)))
+	assert.ElementsMatch(t, []uint64{key(2), key(3), key(4)}, pciCtrl.impactedCells(ctx, key(4)))
+	pciCtrl = NewPciController(store, WithSearchDepth(1))
+	assert.ElementsMatch(t, []uint64{key(1), key(2)}, pciCtrl.impactedCells(ctx, key(1)))
+}
+
+func TestResolveImpacted(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	// the locked cells 1 and 4 are confused as neighbors of cell 2
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 2, neighbors: []byte{1, 3, 4}},
+		{nci: 3, arfcn: 100, pci: 3, neighbors: []byte{2}},
+		{nci: 4, arfcn: 100, pci: 1, neighbors: []byte{2}},
+	})
+	assert.NoError(t, store.SetLocked(ctx, metrics.NewKey(testCGI(1)), true))
+	assert.NoError(t, store.SetLocked(ctx, metrics.NewKey(testCGI(4)), true))
+	pciCtrl := NewPciController(store, WithWorkers(2))
+	done := make(chan struct{})
+	go func() {
+		pciCtrl.resolvePciConflict(ctx)
+		close(done)
+	}()
+	assert.Eventually(t, func() bool {
+		return len(store.WatcherStats(ctx)) == 1
+	}, time.Second, 10*time.Millisecond)
+	noConflicts := func() bool {
+		conflicts, err := pciCtrl.DetectAllConflicts(ctx)
+		return err == nil && len(conflicts) == 0
+	}
+
+	// unlocking cell 4 lets the confusion be resolved
+	assert.NoError(t, store.SetLocked(ctx, metrics.NewKey(testCGI(4)), false))
+	assert.Eventually(t, noConflicts, time.Second, 10*time.Millisecond)
+
+	// the PCI change of cell 3 confuses cells 1 and 3, neighbors of cell 2
+	assert.NoError(t, store.UpdatePci(ctx, metrics.NewKey(testCGI(3)), 1, 0))
+	assert.Eventually(t, noConflicts, time.Second, 10*time.Millisecond)
+
+	// the workers are done once it returns
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		assert.Fail(t, "conflict resolution not stopped")
+	}
+}
+
+func TestNeighborhood(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	// a chain of cells, each listing the next one
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 2, neighbors: []byte{3}},
+		{nci: 3, arfcn: 100, pci: 3, neighbors: []byte{4}},
+		{nci: 4, arfcn: 100, pci: 4, neighbors: []byte{5}},
+		{nci: 5, arfcn: 100, pci: 5, neighbors: []byte{6}},
+		{nci: 6, arfcn: 100, pci: 6, neighbors: []byte{7}},
+		{nci: 7, arfcn: 100, pci: 7, neighbors: []byte{8}},
+		{nci: 8, arfcn: 100, pci: 8},
+	})
+	key := func(nci byte) uint64 {
+		return metrics.NewKey(testCGI(nci))
+	}
+
+	// the confused peer of a neighbor is changed three hops away, and the change reads the PCI one hop further
+	pciCtrl := NewPciController(store, WithSearchDepth(1))
+	assert.Equal(t, []uint64{key(1), key(2), key(3), key(4), key(5)}, pciCtrl.neighborhood(ctx, key(1)))
+	assert.Equal(t, []uint64{key(1), key(2)}, pciCtrl.changeNeighborhood(ctx, key(1)))
+
+	// the cells changed twice the search depth away read the PCIs within the search depth of them
+	pciCtrl = NewPciController(store, WithSearchDepth(2))
+	assert.Equal(t, []uint64{key(1), key(2), key(3), key(4), key(5), key(6), key(7)}, pciCtrl.neighborhood(ctx, key(1)))
+}
+
+func TestResolveSettlingUnlocked(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+	})
+	key1, key2 := metrics.NewKey(testCGI(1)), metrics.NewKey(testCGI(2))
+	assert.NoError(t, store.SetLocked(ctx, key1, true))
+	assert.NoError(t, store.SetLocked(ctx, key2, true))
+	pciCtrl := NewPciController(store, WithSettleWindows(time.Minute, 0))
+	go pciCtrl.resolvePciConflict(ctx)
+	assert.Eventually(t, func() bool {
+		return len(store.WatcherStats(ctx)) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// unlocking a cell is not a change of the controller, even if the cell is settling after one
+	pciCtrl.stabilizer.recordChange(key2, "", time.Now())
+	assert.NoError(t, store.SetLocked(ctx, key2, false))
+	assert.Eventually(t, func() bool {
+		conflicts, err := pciCtrl.DetectAllConflicts(ctx)
+		return err == nil && len(conflicts) == 0
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,311 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/onosproject/onos-pci/pkg/store/history"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+)
+
+// PciChange is a PCI change of a cell in an optimization plan
+type PciChange struct {
+	Key    uint64
+	OldPCI int32
+	NewPCI int32
+}
+
+// layer is the RAT and carrier of a cell; cells in overlapping layers can conflict
+type layer struct {
+	cgiType parse.CGIType
+	arfcn   int32
+}
+
+// vertex is a cell in the PCI conflict graph
+type vertex struct {
+	key   uint64
+	layer layer
+	pci   int32
+	// entry is nil for the cells only known from neighbor lists, whose PCI cannot be changed by this app
+	entry *metrics.Entry
+	// frozen cells oscillated, so their PCI is not changed by this app until they are unfrozen
+	frozen bool
+	// pciMap has the PCIs of the PciPool that are not reserved for the cell
+	pciMap map[int32]bool
+	// neighbors are the co-channel neighbors, which must not share the PCI and are subject to the modulo rules
+	neighbors map[uint64]*vertex
+	// adjacent are the neighbors and the neighbors of the same cells, which must not share the PCI
+	adjacent map[uint64]*vertex
+}
+
+// changeable checks if this app can change the PCI of the cell
+func (v *vertex) changeable() bool {
+	return v.entry != nil && !v.entry.Value.Locked && !v.entry.Value.Stale && !v.frozen
+}
+
+// conflicts counts the adjacent vertices sharing the PCI, and the PCI out of the PciPool or reserved as a conflict
+func (v *vertex) conflicts() int {
+	conflicts := 0
+	if _, ok := v.pciMap[v.pci]; v.entry != nil && !ok {
+		conflicts++
+	}
+	for _, a := range v.adjacent {
+		if a.pci == v.pci {
+			conflicts++
+		}
+	}
+	return conflicts
+}
+
+// graph is the PCI conflict graph of all cells in store; coloring it without conflicts resolves
+// every collision (adjacent neighbors) and confusion (adjacent neighbors of the same cell)
+type graph struct {
+	vertices  map[uint64]*vertex
+	coChannel func(a layer, b layer) bool
+}
+
+func (g *graph) vertex(key uint64, l layer, pci int32) *vertex {
+	v, ok := g.vertices[key]
+	if !ok {
+		v = &vertex{
+			key:       key,
+			layer:     l,
+			pci:       pci,
+			neighbors: make(map[uint64]*vertex),
+			adjacent:  make(map[uint64]*vertex),
+		}
+		g.vertices[key] = v
+	}
+	return v
+}
+
+func (g *graph) connect(a *vertex, b *vertex) {
+	if a.key == b.key || !g.coChannel(a.layer, b.layer) {
+		return
+	}
+	a.adjacent[b.key] = b
+	b.adjacent[a.key] = a
+}
+
+// buildGraph builds the PCI conflict graph from the cells in store and their neighbor lists
+func (p *PciController) buildGraph(ctx context.Context) (*graph, error) {
+	entries, err := p.listEntries(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &graph{
+		vertices: make(map[uint64]*vertex),
+		coChannel: func(a layer, b layer) bool {
+			return p.isCoChannel(a.cgiType, a.arfcn, b.cgiType, b.arfcn)
+		},
+	}
+	for _, entry := range entries {
+		v := g.vertex(metrics.NewKey(entry.Key.CellGlobalID), layer{
+			cgiType: parse.GetCGIType(entry.Key.CellGlobalID),
+			arfcn:   entry.Value.Metric.ARFCN,
+		}, entry.Value.Metric.PCI)
+		v.entry = entry
+		v.frozen = p.stabilizer.isFrozen(v.key)
+		v.pci = entry.Value.Metric.PCI
+		v.pciMap, err = p.getEmptyPciMap(ctx, entry)
+		if err != nil {
+			log.Warnf("PciPool of %v is invalid: %v", v.key, err)
+		}
+	}
+	for _, entry := range entries {
+		v := g.vertices[metrics.NewKey(entry.Key.CellGlobalID)]
+		neighbors := make([]*vertex, 0)
+		for _, n := range p.getNeighbors(ctx, entry) {
+			nv := g.vertex(n.key, layer{cgiType: n.cgiType, arfcn: n.arfcn}, n.pci)
+			neighbors = append(neighbors, nv)
+			if g.coChannel(nv.layer, v.layer) {
+				v.neighbors[nv.key] = nv
+				nv.neighbors[v.key] = v
+				g.connect(v, nv)
+			}
+		}
+		for i := 0; i < len(neighbors); i++ {
+			for j := i + 1; j < len(neighbors); j++ {
+				g.connect(neighbors[i], neighbors[j])
+			}
+		}
+	}
+	return g, nil
+}
+
+// Optimize solves the PCI assignment of all cells in store as a graph-coloring problem per layer, aiming at the
+// minimum number of changes: starting from the current PCIs, it repeatedly recolors the cell involved in the most
+// conflicts, or the least disruptive one among them, with the free PCI of its pool with the least modulo interference, until no conflict can be resolved
+func (p *PciController) Optimize(ctx context.Context) ([]PciChange, error) {
+	g, err := p.buildGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]uint64, 0, len(g.vertices))
+	for key := range g.vertices {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+
+	originalPcis := make(map[uint64]int32)
+	unresolvable := make(map[uint64]bool)
+	for {
+		// every recoloring removes at least one conflict without adding any, so this loop terminates
+		var target *vertex
+		for _, key := range keys {
+			v := g.vertices[key]
+			if !v.changeable() || unresolvable[key] || v.conflicts() == 0 {
+				continue
+			}
+			// among the cells with the most conflicts, the least disruptive one is recolored
+			if target == nil || v.conflicts() > target.conflicts() ||
+				(v.conflicts() == target.conflicts() && newDisruption(v.entry, v.frozen).less(newDisruption(target.entry, target.frozen))) {
+				target = v
+			}
+		}
+		if target == nil {
+			break
+		}
+
+		pci, ok := p.recolor(target)
+		if !ok {
+			log.Warnf("All PCIs in the PciPool of %v are used by its adjacent cells", target.key)
+			unresolvable[target.key] = true
+			continue
+		}
+		if _, ok := originalPcis[target.key]; !ok {
+			originalPcis[target.key] = target.pci
+		}
+		target.pci = pci
+	}
+
+	plan := make([]PciChange, 0)
+	for _, key := range keys {
+		if oldPci, ok := originalPcis[key]; ok && oldPci != g.vertices[key].pci {
+			plan = append(plan, PciChange{
+				Key:    key,
+				OldPCI: oldPci,
+				NewPCI: g.vertices[key].pci,
+			})
+		}
+	}
+	return plan, nil
+}
+
+// recolor picks the PCI for a vertex that is not used by any adjacent vertex
+func (p *PciController) recolor(v *vertex) (int32, bool) {
+	pciMap := make(map[int32]bool, len(v.pciMap))
+	for pci := range v.pciMap {
+		pciMap[pci] = false
+	}
+	for _, a := range v.adjacent {
+		pciMap[a.pci] = true
+	}
+	candidates := make([]int32, 0)
+	for pci, occupied := range pciMap {
+		if !occupied {
+			candidates = append(candidates, pci)
+		}
+	}
+	if len(candidates) == 0 {
+		return 0, false
+	}
+
+	neighbors := make([]neighborCell, 0, len(v.neighbors))
+	for _, n := range v.neighbors {
+		neighbors = append(neighbors, neighborCell{
+			key:     n.key,
+			cgiType: n.layer.cgiType,
+			arfcn:   n.layer.arfcn,
+			pci:     n.pci,
+		})
+	}
+	return p.selectPci(v.entry, candidates, neighbors), true
+}
+
+// ApplyPlan updates the PCIs of an optimization plan in store, which triggers the RC control messages
+func (p *PciController) ApplyPlan(ctx context.Context, plan []PciChange) error {
+	var err error
+	for _, change := range plan {
+		log.Infof("Applying optimized PCI for %v: %v -> %v", change.Key, change.OldPCI, change.NewPCI)
+		neighborhood := p.changeNeighborhood(ctx, change.Key)
+		p.neighborhoods.lock(neighborhood)
+		updateErr := p.changePci(ctx, change.Key, change.NewPCI, history.Optimization, nil)
+		p.neighborhoods.unlock(neighborhood)
+		if updateErr != nil {
+			log.Error(updateErr)
+			err = updateErr
+		}
+	}
+	return err
+}
+
+// runOptimizer optimizes the PCIs of all cells periodically, if any cell changed since the last optimization
+func (p *PciController) runOptimizer(ctx context.Context) {
+	// the watch blocks the store changes while it lags behind, so the events are read apart from the optimization,
+	// which changes the store; they only mark that a cell changed
+	ch := make(chan metrics.Event)
+	err := p.metricStore.Watch(ctx, ch, metrics.WithEventTypes(metrics.Created, metrics.Updated, metrics.Deleted,
+		metrics.ControlResult))
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	changed := make(chan struct{}, 1)
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for e := range ch {
+			// PCI updates are the result of the previous optimization, so they are not watched,
+			// and neither are the indication messages reporting them
+			if e.Type == metrics.Created && p.stabilizer.settling(e.Key, e.Value.Value.Cluster, time.Now()) {
+				continue
+			}
+			// a cell rolled back to its previous PCI after its change failed is optimized again
+			if e.Type == metrics.ControlResult && (e.Value.Value.LastControl == nil || !e.Value.Value.LastControl.RolledBack) {
+				continue
+			}
+			log.Debugf("Cell %v changed: %v", e.Key, e.Type)
+			select {
+			case changed <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(p.optimizationInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closed:
+			return
+		case <-ticker.C:
+		}
+		select {
+		case <-changed:
+		default:
+			continue
+		}
+		plan, err := p.Optimize(ctx)
+		if err != nil {
+			log.Errorf("skip PCI optimization due to %v", err)
+			continue
+		}
+		log.Infof("PCI optimization plan has %v changes", len(plan))
+		err = p.ApplyPlan(ctx, plan)
+		if err != nil {
+			log.Error(err)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
+	"github.com/onosproject/onos-lib-go/api/asn1/v1/asn1"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/store/reserved"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testCell struct {
+	nci       byte
+	arfcn     int32
+	pci       int32
+	neighbors []byte
+}
+
+func newTestNRCgi(nci byte) *e2smrccomm.NrCgi {
+	return &e2smrccomm.NrCgi{
+		PLmnidentity: &e2smrccomm.Plmnidentity{Value: []byte{38, 132, 19}},
+		NRcellIdentity: &e2smrccomm.NrcellIdentity{
+			Value: &asn1.BitString{Value: []byte{0x00, 0x00, 0x00, 0x00, nci << 4}, Len: 36},
+		},
+	}
+}
+
+func testCGI(nci byte) *e2smrccomm.Cgi {
+	return &e2smrccomm.Cgi{Cgi: &e2smrccomm.Cgi_NRCgi{NRCgi: newTestNRCgi(nci)}}
+}
+
+// putTestCells puts NR cells with PCI pool 1..10 and their co-channel neighbor lists in a store
+func putTestCells(t *testing.T, store metrics.Store, cells []testCell) {
+	pcis := make(map[byte]int32)
+	arfcns := make(map[byte]int32)
+	for _, c := range cells {
+		pcis[c.nci] = c.pci
+		arfcns[c.nci] = c.arfcn
+	}
+	for _, c := range cells {
+		neighbors := make([]*e2smrc.NeighborCellItem, 0)
+		for _, n := range c.neighbors {
+			neighbors = append(neighbors, &e2smrc.NeighborCellItem{
+				NeighborCellItem: &e2smrc.NeighborCellItem_RanTypeChoiceNr{
+					RanTypeChoiceNr: &e2smrc.NeighborCellItemChoiceNr{
+						NRCgi: newTestNRCgi(n),
+						NRPci: &e2smrccomm.NrPci{Value: pcis[n]},
+						NRFreqInfo: &e2smrccomm.NrfrequencyInfo{
+							NrArfcn: &e2smrccomm.NrArfcn{NRarfcn: arfcns[n]},
+						},
+					},
+				},
+			})
+		}
+		cgi := testCGI(c.nci)
+		_, err := store.Put(context.Background(), metrics.NewKey(cgi), metrics.Entry{
+			Key: metrics.Key{CellGlobalID: cgi},
+			Value: types.CellPCI{
+				Metric: &types.CellMetric{
+					ARFCN: c.arfcn,
+					PCI:   c.pci,
+				},
+				PCIPoolList: []*types.PCIPool{{LowerPci: 1, UpperPci: 10}},
+				Neighbors:   neighbors,
+			},
+		})
+		assert.NoError(t, err)
+	}
+}
+
+// getTestEntry gets the entry of a cell as it is in store now
+func getTestEntry(t *testing.T, store metrics.Store, nci byte) *metrics.Entry {
+	entry, err := store.Get(context.Background(), metrics.NewKey(testCGI(nci)))
+	assert.NoError(t, err)
+	return entry
+}
+
+func TestOptimize(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	// cell 1 collides with cell 2, and cells 2 and 3 are confused at cell 1; cell 4 is on another ARFCN
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2, 3, 4}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+		{nci: 3, arfcn: 100, pci: 1, neighbors: []byte{1}},
+		{nci: 4, arfcn: 200, pci: 1, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store, WithMode(OptimizerMode))
+
+	conflicts, err := pciCtrl.DetectAllConflicts(ctx)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, conflicts)
+
+	plan, err := pciCtrl.Optimize(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, plan, 2)
+	for _, change := range plan {
+		assert.Equal(t, int32(1), change.OldPCI)
+		assert.NotEqual(t, int32(1), change.NewPCI)
+	}
+
+	assert.NoError(t, pciCtrl.ApplyPlan(ctx, plan))
+	conflicts, err = pciCtrl.DetectAllConflicts(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, conflicts)
+
+	plan, err = pciCtrl.Optimize(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, plan)
+}
+
+func TestOptimizeReservedPci(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 2, neighbors: []byte{1}},
+	})
+	reservedStore := reserved.NewStore()
+	assert.NoError(t, reservedStore.Set(ctx, reserved.Key{Scope: reserved.Global}, []int32{1, 3}))
+	pciCtrl := NewPciController(store, WithReservedStore(reservedStore))
+
+	plan, err := pciCtrl.Optimize(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []PciChange{{Key: metrics.NewKey(testCGI(1)), OldPCI: 1, NewPCI: 4}}, plan)
+}
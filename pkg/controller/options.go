@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/store/history"
+	"github.com/onosproject/onos-pci/pkg/store/proposals"
+	"github.com/onosproject/onos-pci/pkg/store/reserved"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+)
+
+// DefaultModRules are the PCI modulo rules used for a RAT when no rules are configured:
+// PCI mod 3 for PSS/SSS (NR) or PSS/CRS (EUTRA) and PCI mod 30 for DMRS/SRS (NR) or UL RS (EUTRA)
+var DefaultModRules = []types.ModRule{
+	{
+		Modulus: 3,
+		Weight:  1,
+	},
+	{
+		Modulus: 30,
+		Weight:  1,
+	},
+}
+
+// DefaultSearchDepth is the default depth of the neighbor traversal when searching the PCIs occupied around a cell:
+// neighbor only = 1; neighbor and neighbor's neighbor = 2
+const DefaultSearchDepth = 2
+
+// DefaultOptimizationInterval is the default period of the PCI optimization in optimizer mode
+const DefaultOptimizationInterval = 30 * time.Second
+
+// DefaultProposalExpiry is the default time after which a pending PCI change proposal expires
+const DefaultProposalExpiry = 10 * time.Minute
+
+// DefaultSettleWindow is the default time during which the indication messages of a cell changed by the controller
+// are not re-evaluated
+const DefaultSettleWindow = 30 * time.Second
+
+// DefaultOscillationLimit is the default number of changes of a cell within the oscillation window freezing it
+const DefaultOscillationLimit = 5
+
+// DefaultOscillationWindow is the default window of the oscillation detection
+const DefaultOscillationWindow = 10 * time.Minute
+
+// DefaultWorkers is the default number of workers resolving the conflicts of the cells in parallel
+const DefaultWorkers = 4
+
+// DefaultVerificationTimeout is the default time within which an indication message has to confirm a PCI change
+const DefaultVerificationTimeout = 2 * time.Minute
+
+// Mode is the controller operation mode
+type Mode int
+
+const (
+	// ReactiveMode resolves the conflicts of a cell whenever an indication message of the cell arrives
+	ReactiveMode Mode = iota
+	// OptimizerMode periodically solves the PCI assignment of all cells as a graph-coloring problem
+	OptimizerMode
+)
+
+func (m Mode) String() string {
+	return [...]string{"reactive", "optimizer"}[m]
+}
+
+// ParseMode parses the controller operation mode from its name
+func ParseMode(name string) (Mode, error) {
+	for _, mode := range []Mode{ReactiveMode, OptimizerMode} {
+		if mode.String() == name {
+			return mode, nil
+		}
+	}
+	return ReactiveMode, errors.NewInvalid("unknown PCI controller mode %s", name)
+}
+
+// Options controller options
+type Options struct {
+	// ModRules are the PCI modulo rules per RAT
+	ModRules map[parse.CGIType][]types.ModRule
+
+	// SearchDepth is the depth of the neighbor traversal when searching the PCIs occupied around a cell
+	SearchDepth int
+
+	// Bandwidths are the channel bandwidths per RAT to check if the carriers of two cells overlap
+	Bandwidths map[parse.CGIType]types.Bandwidths
+
+	Mode Mode
+
+	// OptimizationInterval is the period of the PCI optimization in optimizer mode
+	OptimizationInterval time.Duration
+
+	// ReservedStore has the PCIs that must not be assigned to the cells
+	ReservedStore reserved.Store
+
+	// DryRun records the PCI changes as proposals instead of applying them, until they are approved
+	DryRun bool
+
+	// ProposalStore has the PCI changes proposed in dry-run mode
+	ProposalStore proposals.Store
+
+	// ProposalExpiry is the time after which a pending proposal expires
+	ProposalExpiry time.Duration
+
+	// HistoryStore has the history of the PCI changes
+	HistoryStore history.Store
+
+	// StaleTTL is the time without indication messages after which a cell is stale; 0 disables it
+	StaleTTL time.Duration
+
+	// EvictStale removes the stale cells from the store instead of marking them
+	EvictStale bool
+
+	// StaleOccupiesPci makes the stale cells still occupy their PCI for their neighbors
+	StaleOccupiesPci bool
+
+	// VerificationTimeout is the time within which an indication message has to confirm a PCI change
+	VerificationTimeout time.Duration
+
+	// CellSettleWindow and ClusterSettleWindow are the times during which the indication messages of a cell,
+	// and of the cells of its cluster, are not re-evaluated after the controller changed its PCI; 0 disables them
+	CellSettleWindow    time.Duration
+	ClusterSettleWindow time.Duration
+
+	// OscillationLimit is the number of changes of a cell within OscillationWindow freezing it; 0 disables it
+	OscillationLimit  int
+	OscillationWindow time.Duration
+
+	// Workers is the number of workers resolving the conflicts of the cells in parallel; the work on cells whose
+	// neighborhoods overlap is serialized
+	Workers int
+}
+
+// Option option interface
+type Option interface {
+	apply(*Options)
+}
+
+type funcOption struct {
+	f func(*Options)
+}
+
+func (f funcOption) apply(options *Options) {
+	f.f(options)
+}
+
+func newOption(f func(*Options)) Option {
+	return funcOption{
+		f: f,
+	}
+}
+
+// WithModRules sets the PCI modulo rules for the cells with a given CGI type
+func WithModRules(cgiType parse.CGIType, rules []types.ModRule) Option {
+	return newOption(func(options *Options) {
+		options.ModRules[cgiType] = rules
+	})
+}
+
+// WithSearchDepth sets the depth of the neighbor traversal when searching the PCIs occupied around a cell
+func WithSearchDepth(depth int) Option {
+	return newOption(func(options *Options) {
+		options.SearchDepth = depth
+	})
+}
+
+// WithBandwidths sets the channel bandwidths for the cells with a given CGI type
+func WithBandwidths(cgiType parse.CGIType, bandwidths types.Bandwidths) Option {
+	return newOption(func(options *Options) {
+		options.Bandwidths[cgiType] = bandwidths
+	})
+}
+
+// WithMode sets the controller operation mode
+func WithMode(mode Mode) Option {
+	return newOption(func(options *Options) {
+		options.Mode = mode
+	})
+}
+
+// WithOptimizationInterval sets the period of the PCI optimization in optimizer mode
+func WithOptimizationInterval(interval time.Duration) Option {
+	return newOption(func(options *Options) {
+		options.OptimizationInterval = interval
+	})
+}
+
+// WithReservedStore sets the store of the PCIs that must not be assigned to the cells
+func WithReservedStore(reservedStore reserved.Store) Option {
+	return newOption(func(options *Options) {
+		options.ReservedStore = reservedStore
+	})
+}
+
+// WithDryRun sets whether the PCI changes are only proposed, not applied until approved
+func WithDryRun(dryRun bool) Option {
+	return newOption(func(options *Options) {
+		options.DryRun = dryRun
+	})
+}
+
+// WithProposalStore sets the store of the PCI changes proposed in dry-run mode
+func WithProposalStore(proposalStore proposals.Store) Option {
+	return newOption(func(options *Options) {
+		options.ProposalStore = proposalStore
+	})
+}
+
+// WithProposalExpiry sets the time after which a pending PCI change proposal expires
+func WithProposalExpiry(expiry time.Duration) Option {
+	return newOption(func(options *Options) {
+		options.ProposalExpiry = expiry
+	})
+}
+
+// WithHistoryStore sets the store of the history of the PCI changes
+func WithHistoryStore(historyStore history.Store) Option {
+	return newOption(func(options *Options) {
+		options.HistoryStore = historyStore
+	})
+}
+
+// WithStaleTTL sets the time without indication messages after which a cell is stale, and whether it is removed then;
+// a TTL which is not positive disables it
+func WithStaleTTL(ttl time.Duration, evict bool) Option {
+	return newOption(func(options *Options) {
+		options.StaleTTL = ttl
+		options.EvictStale = evict
+	})
+}
+
+// WithStaleOccupiesPci sets whether the stale cells still occupy their PCI for their neighbors
+func WithStaleOccupiesPci(occupies bool) Option {
+	return newOption(func(options *Options) {
+		options.StaleOccupiesPci = occupies
+	})
+}
+
+// WithVerificationTimeout sets the time within which an indication message has to confirm a PCI change
+func WithVerificationTimeout(timeout time.Duration) Option {
+	return newOption(func(options *Options) {
+		options.VerificationTimeout = timeout
+	})
+}
+
+// WithSettleWindows sets the times during which the indication messages of a cell, and of the cells of its cluster,
+// are not re-evaluated after the controller changed its PCI
+func WithSettleWindows(cell time.Duration, cluster time.Duration) Option {
+	return newOption(func(options *Options) {
+		options.CellSettleWindow = cell
+		options.ClusterSettleWindow = cluster
+	})
+}
+
+// WithOscillationDetection sets the number of changes of a cell within a window freezing it
+func WithOscillationDetection(limit int, window time.Duration) Option {
+	return newOption(func(options *Options) {
+		options.OscillationLimit = limit
+		options.OscillationWindow = window
+	})
+}
+
+// WithWorkers sets the number of workers resolving the conflicts of the cells in parallel
+func WithWorkers(workers int) Option {
+	return newOption(func(options *Options) {
+		options.Workers = workers
+	})
+}
+
+// checkDivisor is the fraction of a deadline at which the deadlines are checked, so that nothing is left
+// more than a tenth of its deadline past it
+const checkDivisor = 10
+
+// minCheckInterval keeps the deadlines shorter than it from being checked continuously
+const minCheckInterval = time.Millisecond
+
+// checkInterval returns the interval at which the deadlines of a given duration are checked
+func checkInterval(d time.Duration) time.Duration {
+	if interval := d / checkDivisor; interval > minCheckInterval {
+		return interval
+	}
+	return minCheckInterval
+}
@@ -0,0 +1,18 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckInterval(t *testing.T) {
+	assert.Equal(t, 6*time.Minute, checkInterval(time.Hour))
+	assert.Equal(t, minCheckInterval, checkInterval(time.Nanosecond))
+}
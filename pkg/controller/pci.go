@@ -7,67 +7,209 @@ package controller
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
+	"github.com/onosproject/onos-pci/pkg/store/history"
 	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/store/proposals"
+	"github.com/onosproject/onos-pci/pkg/store/reserved"
 	"github.com/onosproject/onos-pci/pkg/types"
 	"github.com/onosproject/onos-pci/pkg/utils/decode"
 	"github.com/onosproject/onos-pci/pkg/utils/parse"
 )
 
-// SearchDepth indicates how deep it will search in metrics store
-// neighbor only = 1; neighbor and neighbor's neighbor = 2
-const SearchDepth = 2
-
 var log = logging.GetLogger()
 
-func NewPciController(store metrics.Store) PciController {
+func NewPciController(store metrics.Store, opts ...Option) PciController {
+	options := Options{
+		ModRules: map[parse.CGIType][]types.ModRule{
+			parse.CGITypeNrCGI: DefaultModRules,
+			parse.CGITypeECGI:  DefaultModRules,
+		},
+		SearchDepth:          DefaultSearchDepth,
+		Bandwidths:           make(map[parse.CGIType]types.Bandwidths),
+		Mode:                 ReactiveMode,
+		OptimizationInterval: DefaultOptimizationInterval,
+		ReservedStore:        reserved.NewStore(),
+		ProposalStore:        proposals.NewStore(),
+		ProposalExpiry:       DefaultProposalExpiry,
+		HistoryStore:         history.NewStore(history.DefaultLimit),
+		VerificationTimeout:  DefaultVerificationTimeout,
+		CellSettleWindow:     DefaultSettleWindow,
+		OscillationLimit:     DefaultOscillationLimit,
+		OscillationWindow:    DefaultOscillationWindow,
+		Workers:              DefaultWorkers,
+	}
+
+	for _, opt := range opts {
+		opt.apply(&options)
+	}
 	return PciController{
-		metricStore: store,
+		metricStore:          store,
+		modRules:             options.ModRules,
+		searchDepth:          options.SearchDepth,
+		bandwidths:           options.Bandwidths,
+		mode:                 options.Mode,
+		optimizationInterval: options.OptimizationInterval,
+		reservedStore:        options.ReservedStore,
+		dryRun:               options.DryRun,
+		proposalStore:        options.ProposalStore,
+		proposalExpiry:       options.ProposalExpiry,
+		historyStore:         options.HistoryStore,
+		staleTTL:             options.StaleTTL,
+		evictStale:           options.EvictStale,
+		staleOccupiesPci:     options.StaleOccupiesPci,
+		verifier:             newVerifier(options.VerificationTimeout),
+		stabilizer:           newStabilizer(options),
+		workers:              options.Workers,
+		neighborhoods:        newNeighborhoodLocks(),
+		changeIDs:            newChangeIDs(),
 	}
 }
 
 type PciController struct {
-	metricStore metrics.Store
+	metricStore          metrics.Store
+	modRules             map[parse.CGIType][]types.ModRule
+	searchDepth          int
+	bandwidths           map[parse.CGIType]types.Bandwidths
+	mode                 Mode
+	optimizationInterval time.Duration
+	reservedStore        reserved.Store
+	dryRun               bool
+	proposalStore        proposals.Store
+	proposalExpiry       time.Duration
+	historyStore         history.Store
+	staleTTL             time.Duration
+	evictStale           bool
+	staleOccupiesPci     bool
+	verifier             *verifier
+	stabilizer           *stabilizer
+	workers              int
+	neighborhoods        *neighborhoodLocks
+	changeIDs            *changeIDs
 }
 
 func (p *PciController) Run(ctx context.Context) {
+	log.Infof("Running PCI controller in %v mode (dry run: %v)", p.mode, p.dryRun)
+	if err := p.recordControlOutcomes(ctx); err != nil {
+		log.Error(err)
+	}
+	if err := p.verifyChanges(ctx); err != nil {
+		log.Error(err)
+	}
+	if err := p.forgetDeletedCells(ctx); err != nil {
+		log.Error(err)
+	}
+	if p.dryRun {
+		go p.runProposalExpiry(ctx)
+	}
+	if p.staleTTL > 0 {
+		go p.runStaleExpiry(ctx)
+	}
+	if p.mode == OptimizerMode {
+		go p.runOptimizer(ctx)
+		return
+	}
 	go p.resolvePciConflict(ctx)
 }
 
 func (p *PciController) resolvePciConflict(ctx context.Context) {
+	// lock and staleness changes, PCI changes, removals and control outcomes are not sent again, so the store changes
+	// wait for the watch rather than the events being dropped if it lags behind; the loop only feeds the work queue,
+	// which coalesces the events of each cell, and the workers changing the store are apart from it
 	ch := make(chan metrics.Event)
-	err := p.metricStore.Watch(ctx, ch)
+	err := p.metricStore.Watch(ctx, ch, metrics.WithEventTypes(metrics.Created, metrics.Updated, metrics.UpdatedPCI,
+		metrics.Deleted, metrics.ControlResult))
 	if err != nil {
 		log.Error(err)
 	}
+	queue := newWorkQueue()
+	// the workers are shut down and waited for once the watch is closed
+	var workers sync.WaitGroup
+	defer workers.Wait()
+	defer queue.shutDown()
+	// at least one worker is started
+	count := p.workers
+	if count < 1 {
+		count = 1
+	}
+	for i := 0; i < count; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			p.processWorkItems(ctx, queue)
+		}()
+	}
+	lists := make(neighborLists)
 	for e := range ch {
-		// new indication message arrives
-		if e.Type == metrics.Created {
-			log.Debugf("new event indication message key: %v / value: %v / event type: %v",
-				e.Key, e.Value, e.Type)
-
-			pci, changed, err := p.getAvailablePci(ctx, &e.Value)
-			if err != nil {
-				log.Errorf("skip pci logic for event %v due to %v", e, err)
-				continue
+		if e.Type == metrics.Deleted {
+			delete(lists, e.Key)
+			continue
+		}
+		// the previous PCI a cell is rolled back to may conflict with the PCIs its neighbors got meanwhile,
+		// so it is re-evaluated right away, as a PCI change
+		if e.Type == metrics.ControlResult {
+			if control := e.Value.Value.LastControl; control != nil && control.RolledBack {
+				queue.add(e.Key, true)
 			}
+			continue
+		}
+		// new indication messages, lock and staleness changes, and PCI changes; the controller's own changes,
+		// and the indication messages reporting them, are not re-evaluated, but lock and staleness changes are
+		if e.Type != metrics.Updated && p.stabilizer.settling(e.Key, e.Value.Value.Cluster, time.Now()) {
+			log.Debugf("skip pci logic for %v settling after a PCI change", e.Key)
+			continue
+		}
+		log.Debugf("new event key: %v / value: %v / event type: %v", e.Key, e.Value, e.Type)
+		queue.add(e.Key, lists.changed(e.Key, e.Value) || e.Type == metrics.UpdatedPCI)
+	}
+}
 
-			if changed {
-				log.Debugf("NewPCI for %v: %v", e.Value.Key, pci)
-				err := p.metricStore.UpdatePci(ctx, metrics.NewKey(e.Value.Key.CellGlobalID), pci)
-				if err != nil {
-					log.Error(err)
-				}
+// processWorkItems resolves the conflicts of the queued cells until the queue is shut down; the work on cells
+// whose neighborhoods overlap is serialized
+func (p *PciController) processWorkItems(ctx context.Context, queue *workQueue) {
+	for {
+		item, ok := queue.get()
+		if !ok {
+			return
+		}
+		neighborhood := p.neighborhood(ctx, item.key)
+		p.neighborhoods.lock(neighborhood)
+		p.resolveImpacted(ctx, item)
+		p.neighborhoods.unlock(neighborhood)
+		queue.done(item.key)
+	}
+}
+
+// resolveConfusions changes the PCI of the least disruptive one of each pair of the entry's neighbors sharing a PCI;
+// the confusions the entry itself is involved in are resolved together with its collisions
+func (p *PciController) resolveConfusions(ctx context.Context, entry *metrics.Entry) {
+	for _, c := range p.getConfusions(entry, p.getNeighbors(ctx, entry)) {
+		log.Infof("PCI confusion detected: cells %v and %v share PCI %v and are neighbors of %v",
+			c.CellID, c.PeerID, c.PCI, c.CommonNeighborID)
+		targets := make([]*metrics.Entry, 0, 2)
+		for _, id := range []uint64{c.CellID, c.PeerID} {
+			// the PCIs may have been changed while resolving an earlier confusion
+			if target, err := p.metricStore.Get(ctx, id); err == nil {
+				targets = append(targets, target)
 			}
 		}
+		if len(targets) == 0 {
+			log.Warnf("skip resolving confusion %v since neither cell is connected to this app", c)
+			continue
+		}
+		if err := p.changeLeastDisruptive(ctx, targets); err != nil {
+			log.Errorf("skip resolving confusion %v due to %v", c, err)
+		}
 	}
 }
 
 func (p *PciController) getAvailablePci(ctx context.Context, entry *metrics.Entry) (int32, bool, error) {
-	pciMap, err := p.getEmptyPciMap(entry.Value.PCIPoolList)
+	pciMap, err := p.getEmptyPciMap(ctx, entry)
 	if err != nil {
 		return 0, false, err
 	}
@@ -78,25 +220,75 @@ func (p *PciController) getAvailablePci(ctx context.Context, entry *metrics.Entr
 		return 0, false, err
 	}
 
-	// if the PCI that entry has is not occupied by the other cells in the scope (depth), just use it
-	if !pciMap[entry.Value.Metric.PCI] {
+	// if the PCI that entry has is in its PciPool, not reserved and not occupied by the other cells in the scope (depth), just use it
+	if occupied, ok := pciMap[entry.Value.Metric.PCI]; ok && !occupied {
 		return 0, false, nil
 	}
+	// a locked or frozen cell keeps its PCI, so the conflict has to be resolved on the other side
+	if entry.Value.Locked {
+		return 0, false, errors.NewConflict("PCI %v of locked cell %v conflicts with the other cells in the scope",
+			entry.Value.Metric.PCI, metrics.NewKey(entry.Key.CellGlobalID))
+	}
+	if p.stabilizer.isFrozen(metrics.NewKey(entry.Key.CellGlobalID)) {
+		return 0, false, errors.NewConflict("PCI %v of frozen cell %v conflicts with the other cells in the scope",
+			entry.Value.Metric.PCI, metrics.NewKey(entry.Key.CellGlobalID))
+	}
 
-	// Pick one of PCIs in map, if the selected PCI is not occupied
+	// Pick the PCI with the least interference with co-channel neighbors among the PCIs not occupied
+	candidates := make([]int32, 0)
 	for k, v := range pciMap {
 		if !v {
-			return k, true, nil
+			candidates = append(candidates, k)
 		}
 	}
+	if len(candidates) == 0 {
+		// if all PCIs are occupied by the other cells in the scope (depth), rise error and return the same PCI
+		return 0, false, errors.NewUnavailable("All PCIs in the PciPool are occupied by the other cells in the scope")
+	}
 
-	// if all PCIs are occupied by the other cells in the scope (depth), rise error and return the same PCI
-	return 0, false, errors.NewUnavailable("All PCIs in the PciPool are occupied by the other cells in the scope")
+	return p.selectPci(entry, candidates, p.getNeighbors(ctx, entry)), true, nil
 }
 
-func (p *PciController) getEmptyPciMap(pciPoolList []*types.PCIPool) (map[int32]bool, error) {
+// SetPci sets the PCI of a cell manually: the PCI has to be in the cell's PciPool, not reserved and not occupied by
+// the other cells in the scope (depth). A locked cell can be changed manually too, and it stays locked.
+// It returns the ID of the change, carried by the result of its RC control message.
+func (p *PciController) SetPci(ctx context.Context, key uint64, pci int32) (uint64, error) {
+	neighborhood := p.changeNeighborhood(ctx, key)
+	p.neighborhoods.lock(neighborhood)
+	defer p.neighborhoods.unlock(neighborhood)
+	if err := p.validatePci(ctx, key, pci); err != nil {
+		return 0, err
+	}
+	log.Infof("Set PCI of %v to %v manually", key, pci)
+	return p.updatePci(ctx, key, pci, history.Manual, history.Operator, nil)
+}
+
+// validatePci checks if a PCI can be assigned to a cell
+func (p *PciController) validatePci(ctx context.Context, key uint64, pci int32) error {
+	entry, err := p.metricStore.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	pciMap, err := p.getEmptyPciMap(ctx, entry)
+	if err != nil {
+		return err
+	}
+	if _, ok := pciMap[pci]; !ok {
+		return errors.NewInvalid("PCI %v is not in the PciPool of cell %v or is reserved", pci, key)
+	}
+	if err := p.neighborTraversal(ctx, entry, entry, 1, pciMap); err != nil {
+		return err
+	}
+	if pciMap[pci] {
+		return errors.NewConflict("PCI %v is occupied by the other cells in the scope of cell %v", pci, key)
+	}
+	return nil
+}
+
+// getEmptyPciMap makes a PCI map of the PciPool of an entry without the PCIs reserved for the cell
+func (p *PciController) getEmptyPciMap(ctx context.Context, entry *metrics.Entry) (map[int32]bool, error) {
 	pciMap := make(map[int32]bool)
-	for _, pciPool := range pciPoolList {
+	for _, pciPool := range entry.Value.PCIPoolList {
 		if pciPool.LowerPci > pciPool.UpperPci {
 			return nil, errors.NewUnavailable("lower pci should be lower than upper pci")
 		}
@@ -104,47 +296,58 @@ func (p *PciController) getEmptyPciMap(pciPoolList []*types.PCIPool) (map[int32]
 			pciMap[i] = false
 		}
 	}
+	for pci := range p.reservedStore.GetReservedPcis(ctx, getPlmnID(entry.Key.CellGlobalID), entry.Value.Cluster) {
+		delete(pciMap, pci)
+	}
 	return pciMap, nil
 }
 
+// getPlmnID returns the PLMN ID of a CGI
+func getPlmnID(cgi *e2smrccomm.Cgi) uint32 {
+	var plmnID []byte
+	var err error
+	if cgi.GetNRCgi() != nil {
+		plmnID, _, _, err = parse.GetNRMetricKey(cgi.GetNRCgi())
+	} else if cgi.GetEUtraCgi() != nil {
+		plmnID, _, _, err = parse.GetEUTRAMetricKey(cgi.GetEUtraCgi())
+	}
+	if err != nil || len(plmnID) != 3 {
+		log.Warnf("could not parse PLMN ID of %v", cgi)
+		return 0
+	}
+	return decode.PlmnIDToUint32(plmnID)
+}
+
 func (p *PciController) neighborTraversal(ctx context.Context, root *metrics.Entry, entry *metrics.Entry, cDepth int, pciMap map[int32]bool) error {
 	var err error
-	if cDepth > SearchDepth {
+	if cDepth > p.searchDepth {
 		// if this is the leaf entry, then return
 		return err
 	}
 
 	rootArfcn := root.Value.Metric.ARFCN
+	rootCGIType := parse.GetCGIType(root.Key.CellGlobalID)
 
 	for _, n := range entry.Value.Neighbors {
-		neighborCGI := &e2smrccomm.Cgi{}
-		var pci int32
-		var arfcn int32
-		if n.GetRanTypeChoiceNr() != nil {
-			neighborCGI.Cgi = &e2smrccomm.Cgi_NRCgi{
-				NRCgi: n.GetRanTypeChoiceNr().GetNRCgi(),
-			}
-			pci = n.GetRanTypeChoiceNr().GetNRPci().GetValue()
-			arfcn = n.GetRanTypeChoiceNr().GetNRFreqInfo().GetNrArfcn().GetNRarfcn()
-		} else if n.GetRanTypeChoiceEutra() != nil {
-			neighborCGI.Cgi = &e2smrccomm.Cgi_EUtraCgi{
-				EUtraCgi: n.GetRanTypeChoiceEutra().GetEUtraCgi(),
-			}
-			pci = n.GetRanTypeChoiceEutra().GetEUtraPci().GetValue()
-			arfcn = n.GetRanTypeChoiceEutra().GetEUtraArfcn().GetValue()
-		} else {
+		neighborCGI, pci, arfcn, nErr := parse.GetNeighborInfo(n)
+		if nErr != nil {
 			log.Errorf("Neighbor type should be NR or EUTRAN: %v", n)
 			continue
 		}
+		// NR and EUTRA PCIs are separate spaces, so only neighbors with the same RAT and an overlapping carrier are relevant
+		sameLayer := p.isCoChannel(rootCGIType, rootArfcn, parse.GetCGIType(neighborCGI), arfcn)
 
 		// is CGI root key equal to neighbor CGI? - if so, skip; otherwise, mark pciMap as false
 		if !p.isCGIEqual(root.Key.CellGlobalID, neighborCGI) {
 			neighborEntry := p.getEntryWithNeighborCGI(ctx, neighborCGI)
+			if neighborEntry != nil && p.isIgnored(neighborEntry) {
+				continue
+			}
 			if neighborEntry != nil {
 				// if neighbor metric is in store - search store first:
 				// neighbor metric has more recent PCI than the neighbors field in entry,
 				// because this controller updates PCI in neighbor metric after sending RC-PRE control message
-				if rootArfcn == arfcn {
+				if sameLayer {
 					pciMap[neighborEntry.Value.Metric.PCI] = true
 				}
 				err = p.neighborTraversal(ctx, root, neighborEntry, cDepth+1, pciMap)
@@ -154,7 +357,7 @@ func (p *PciController) neighborTraversal(ctx context.Context, root *metrics.Ent
 			} else {
 				// if neighbor metric is not in store, but in the entry neighbors field
 				// hit here in the case when ind message was not arrived yet or the neighbor is not connected to the E2Nodes subscribing with this app
-				if rootArfcn == arfcn {
+				if sameLayer {
 					pciMap[pci] = true
 				}
 			}
@@ -166,22 +369,12 @@ func (p *PciController) neighborTraversal(ctx context.Context, root *metrics.Ent
 
 // getEntryWithNeighborCGI gets entry in store with CGI value, not entry key (not pointer)
 // used when searching neighbor entry in store
-// since entry key is the pointer of CGI, it is impossible to get entry with CGI in neighbor field
 func (p *PciController) getEntryWithNeighborCGI(ctx context.Context, id *e2smrccomm.Cgi) *metrics.Entry {
-	ch := make(chan *metrics.Entry)
-	var targetEntry *metrics.Entry
-	go func(chan *metrics.Entry) {
-		err := p.metricStore.Entries(ctx, ch)
-		if err != nil {
-			log.Error(err)
-		}
-	}(ch)
-	for entry := range ch {
-		if p.isCGIEqual(id, entry.Key.CellGlobalID) {
-			targetEntry = entry
-		}
+	entry, err := p.metricStore.GetByCGI(ctx, id)
+	if err != nil {
+		return nil
 	}
-	return targetEntry
+	return entry
 }
 
 // isCGIEqual compares CGI values, not pointers
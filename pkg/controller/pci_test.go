@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/store/reserved"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPci(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 2, neighbors: []byte{1}},
+	})
+	reservedStore := reserved.NewStore()
+	assert.NoError(t, reservedStore.Set(ctx, reserved.Key{Scope: reserved.Global}, []int32{5}))
+	pciCtrl := NewPciController(store, WithReservedStore(reservedStore))
+	key := metrics.NewKey(testCGI(1))
+
+	_, err := pciCtrl.SetPci(ctx, metrics.NewKey(testCGI(3)), 3)
+	assert.True(t, errors.IsNotFound(err))
+	// out of the PciPool, reserved and occupied by the neighbor
+	_, err = pciCtrl.SetPci(ctx, key, 11)
+	assert.True(t, errors.IsInvalid(err))
+	_, err = pciCtrl.SetPci(ctx, key, 5)
+	assert.True(t, errors.IsInvalid(err))
+	_, err = pciCtrl.SetPci(ctx, key, 2)
+	assert.True(t, errors.IsConflict(err))
+
+	// a locked cell can be changed manually
+	assert.NoError(t, store.SetLocked(ctx, key, true))
+	_, err = pciCtrl.SetPci(ctx, key, 3)
+	assert.NoError(t, err)
+	entry, err := store.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), entry.Value.Metric.PCI)
+	assert.Equal(t, int32(1), entry.Value.Metric.PreviousPCI)
+	assert.True(t, entry.Value.Locked)
+}
+
+func TestSetPciNeighborhoodLock(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 2, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store, WithSearchDepth(1))
+	key1, key2 := metrics.NewKey(testCGI(1)), metrics.NewKey(testCGI(2))
+
+	// the manual change waits for the work on the neighborhood of the neighbor whose PCI it reads
+	pciCtrl.neighborhoods.lock([]uint64{key2})
+	done := make(chan error)
+	go func() {
+		_, err := pciCtrl.SetPci(ctx, key1, 3)
+		done <- err
+	}()
+	select {
+	case <-done:
+		assert.Fail(t, "PCI set while the neighborhood is locked")
+	case <-time.After(50 * time.Millisecond):
+	}
+	pciCtrl.neighborhoods.unlock([]uint64{key2})
+	assert.NoError(t, <-done)
+}
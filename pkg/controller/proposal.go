@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onosproject/onos-pci/pkg/store/history"
+	"github.com/onosproject/onos-pci/pkg/store/proposals"
+)
+
+// changePci changes the PCI of a cell in store, so that the E2 manager sends the RC control message;
+// in dry-run mode the store is left as it is and the change is only recorded as a proposal to be approved.
+// The caller holds the lock of a neighborhood including the cell and the cells within the search depth of it.
+func (p *PciController) changePci(ctx context.Context, key uint64, pci int32, trigger history.Trigger, conflicting []uint64) error {
+	if !p.dryRun {
+		_, err := p.updatePci(ctx, key, pci, trigger, history.Controller, conflicting)
+		return err
+	}
+	entry, err := p.metricStore.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	neighbors := make([]uint64, 0, len(entry.Value.Neighbors))
+	for _, n := range p.getNeighbors(ctx, entry) {
+		neighbors = append(neighbors, n.key)
+	}
+	reason := trigger.String()
+	if len(conflicting) > 0 {
+		reason = fmt.Sprintf("%v with cells %v", trigger, conflicting)
+	}
+	log.Infof("Proposing PCI for %v: %v -> %v (%v)", entry.Key, entry.Value.Metric.PCI, pci, reason)
+	return p.proposalStore.Put(ctx, proposals.Proposal{
+		CellID:           key,
+		E2NodeID:         entry.Value.E2NodeID,
+		CurrentPCI:       entry.Value.Metric.PCI,
+		ProposedPCI:      pci,
+		Neighbors:        neighbors,
+		ConflictingCells: conflicting,
+		Reason:           reason,
+	})
+}
+
+// DryRun returns whether the PCI changes are only proposed
+func (p *PciController) DryRun() bool {
+	return p.dryRun
+}
+
+// ListProposals lists the pending PCI change proposals
+func (p *PciController) ListProposals(ctx context.Context) ([]*proposals.Proposal, error) {
+	p.expireProposals(ctx)
+	return p.proposalStore.List(ctx)
+}
+
+// ListProposalHistory lists the decided PCI change proposals
+func (p *PciController) ListProposalHistory(ctx context.Context) ([]*proposals.Proposal, error) {
+	p.expireProposals(ctx)
+	return p.proposalStore.History(ctx)
+}
+
+// ApproveProposal applies the PCI change proposed for a cell; the proposed PCI is validated again like a manual change,
+// since the cell or its neighbors may have changed after it was proposed. A proposal which cannot be applied is kept.
+func (p *PciController) ApproveProposal(ctx context.Context, key uint64) (*proposals.Proposal, error) {
+	p.expireProposals(ctx)
+	proposal, err := p.proposalStore.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Approved PCI proposal for %v: %v -> %v", key, proposal.CurrentPCI, proposal.ProposedPCI)
+	neighborhood := p.changeNeighborhood(ctx, key)
+	p.neighborhoods.lock(neighborhood)
+	defer p.neighborhoods.unlock(neighborhood)
+	if err := p.validatePci(ctx, key, proposal.ProposedPCI); err != nil {
+		return nil, err
+	}
+	if _, err := p.updatePci(ctx, key, proposal.ProposedPCI, history.Proposal, history.Operator, proposal.ConflictingCells); err != nil {
+		return nil, err
+	}
+	return p.proposalStore.Decide(ctx, key, proposals.Approved, "")
+}
+
+// RejectProposal rejects the PCI change proposed for a cell; the rejection is recorded in the history
+func (p *PciController) RejectProposal(ctx context.Context, key uint64, reason string) (*proposals.Proposal, error) {
+	p.expireProposals(ctx)
+	log.Infof("Rejected PCI proposal for %v: %v", key, reason)
+	return p.proposalStore.Decide(ctx, key, proposals.Rejected, reason)
+}
+
+// expireProposals closes the proposals which were pending longer than the proposal expiry
+func (p *PciController) expireProposals(ctx context.Context) {
+	expired, err := p.proposalStore.Expire(ctx, time.Now().Add(-p.proposalExpiry))
+	if err != nil {
+		log.Warn(err)
+		return
+	}
+	for _, proposal := range expired {
+		log.Infof("PCI proposal for %v expired: %v -> %v", proposal.CellID, proposal.CurrentPCI, proposal.ProposedPCI)
+	}
+}
+
+// runProposalExpiry expires the stale proposals periodically, so that they are not left pending when nobody looks at them
+func (p *PciController) runProposalExpiry(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval(p.proposalExpiry))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.expireProposals(ctx)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/store/proposals"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDryRun(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2, 3}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+		{nci: 3, arfcn: 100, pci: 2, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store, WithDryRun(true))
+	smallKey := metrics.NewKey(testCGI(2))
+	small, err := store.Get(ctx, smallKey)
+	assert.NoError(t, err)
+
+	// the change is proposed, not applied
+	assert.NoError(t, pciCtrl.changeLeastDisruptive(ctx, pciCtrl.getConflictingEntries(ctx, small)))
+	assert.Equal(t, int32(1), getTestEntry(t, store, 2).Value.Metric.PCI)
+	list, err := pciCtrl.ListProposals(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, smallKey, list[0].CellID)
+	assert.Equal(t, int32(1), list[0].CurrentPCI)
+	proposedPci := list[0].ProposedPCI
+	assert.NotContains(t, []int32{1, 2}, proposedPci)
+	assert.Equal(t, []uint64{metrics.NewKey(testCGI(1))}, list[0].Neighbors)
+	assert.NotEmpty(t, list[0].Reason)
+
+	// the approved change is applied and the proposal is removed
+	proposal, err := pciCtrl.ApproveProposal(ctx, smallKey)
+	assert.NoError(t, err)
+	assert.Equal(t, proposedPci, proposal.ProposedPCI)
+	assert.Equal(t, proposals.Approved, proposal.Status)
+	assert.Equal(t, proposedPci, getTestEntry(t, store, 2).Value.Metric.PCI)
+	_, err = pciCtrl.ApproveProposal(ctx, smallKey)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestRejectProposal(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store, WithDryRun(true))
+	entry, err := store.Get(ctx, metrics.NewKey(testCGI(1)))
+	assert.NoError(t, err)
+	assert.NoError(t, pciCtrl.changeLeastDisruptive(ctx, pciCtrl.getConflictingEntries(ctx, entry)))
+	list, err := pciCtrl.ListProposals(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	key := list[0].CellID
+
+	proposal, err := pciCtrl.RejectProposal(ctx, key, "frozen area")
+	assert.NoError(t, err)
+	assert.Equal(t, proposals.Rejected, proposal.Status)
+	_, err = pciCtrl.ApproveProposal(ctx, key)
+	assert.True(t, errors.IsNotFound(err))
+
+	history, err := pciCtrl.ListProposalHistory(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, "frozen area", history[0].Decision)
+	// the PCIs are not changed
+	entry, err = store.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), entry.Value.Metric.PCI)
+}
+
+func TestExpireProposals(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store, WithDryRun(true), WithProposalExpiry(time.Nanosecond))
+	entry, err := store.Get(ctx, metrics.NewKey(testCGI(1)))
+	assert.NoError(t, err)
+	assert.NoError(t, pciCtrl.changeLeastDisruptive(ctx, pciCtrl.getConflictingEntries(ctx, entry)))
+	time.Sleep(time.Millisecond)
+
+	list, err := pciCtrl.ListProposals(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, list)
+	history, err := pciCtrl.ListProposalHistory(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, history, 1)
+	assert.Equal(t, proposals.Expired, history[0].Status)
+}
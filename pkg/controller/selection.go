@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"sort"
+
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+)
+
+// selectPci picks the candidate PCI with the lowest modulo interference score with the co-channel neighbors
+// of an entry; ties are broken by the lowest PCI so that the selection is deterministic
+func (p *PciController) selectPci(entry *metrics.Entry, candidates []int32, neighbors []neighborCell) int32 {
+	cgiType := parse.GetCGIType(entry.Key.CellGlobalID)
+	neighborPcis := make([]int32, 0, len(neighbors))
+	for _, n := range neighbors {
+		if p.isCoChannel(cgiType, entry.Value.Metric.ARFCN, n.cgiType, n.arfcn) {
+			neighborPcis = append(neighborPcis, n.pci)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i] < candidates[j]
+	})
+	rules := p.modRules[cgiType]
+	best := candidates[0]
+	bestScore := modInterference(rules, best, neighborPcis)
+	for _, candidate := range candidates[1:] {
+		if bestScore == 0 {
+			break
+		}
+		score := modInterference(rules, candidate, neighborPcis)
+		if score < bestScore {
+			best, bestScore = candidate, score
+		}
+	}
+	log.Debugf("Selected PCI %v with interference score %v among %v candidates for %v",
+		best, bestScore, len(candidates), entry.Key)
+	return best
+}
+
+// modInterference scores how much a PCI clashes with the PCIs of co-channel neighbors under the modulo rules
+func modInterference(rules []types.ModRule, pci int32, neighborPcis []int32) int32 {
+	var score int32
+	for _, rule := range rules {
+		for _, neighborPci := range neighborPcis {
+			if pci%rule.Modulus == neighborPci%rule.Modulus {
+				score += rule.Weight
+			}
+		}
+	}
+	return score
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEntry(arfcn int32, pci int32) *metrics.Entry {
+	return &metrics.Entry{
+		Key: metrics.Key{
+			CellGlobalID: &e2smrccomm.Cgi{
+				Cgi: &e2smrccomm.Cgi_NRCgi{NRCgi: &e2smrccomm.NrCgi{}},
+			},
+		},
+		Value: types.CellPCI{
+			Metric: &types.CellMetric{
+				ARFCN: arfcn,
+				PCI:   pci,
+			},
+		},
+	}
+}
+
+func newTestNeighbor(key uint64, arfcn int32, pci int32) neighborCell {
+	return neighborCell{
+		key:     key,
+		cgiType: parse.CGITypeNrCGI,
+		arfcn:   arfcn,
+		pci:     pci,
+	}
+}
+
+func TestModInterference(t *testing.T) {
+	assert.Equal(t, int32(0), modInterference(DefaultModRules, 2, []int32{3, 4}))
+	assert.Equal(t, int32(1), modInterference(DefaultModRules, 1, []int32{3, 4}))
+	// a mod 30 clash is also a mod 3 clash
+	assert.Equal(t, int32(2), modInterference(DefaultModRules, 34, []int32{4}))
+	assert.Equal(t, int32(5), modInterference([]types.ModRule{{Modulus: 3, Weight: 5}}, 7, []int32{4}))
+}
+
+func TestSelectPci(t *testing.T) {
+	pciCtrl := NewPciController(metrics.NewStore())
+	entry := newTestEntry(100, 3)
+	neighbors := []neighborCell{
+		newTestNeighbor(1, 100, 3),
+		newTestNeighbor(2, 100, 4),
+		// not co-channel, so it does not interfere
+		newTestNeighbor(3, 200, 5),
+	}
+
+	assert.Equal(t, int32(2), pciCtrl.selectPci(entry, []int32{6, 5, 2, 1}, neighbors))
+	assert.Equal(t, int32(5), pciCtrl.selectPci(entry, []int32{6, 5, 1}, neighbors))
+	// every candidate clashes once, so the lowest one is picked
+	assert.Equal(t, int32(6), pciCtrl.selectPci(entry, []int32{7, 6}, neighbors))
+}
+
+func TestSelectPciWithModRules(t *testing.T) {
+	pciCtrl := NewPciController(metrics.NewStore(),
+		WithModRules(parse.CGITypeNrCGI, []types.ModRule{{Modulus: 4, Weight: 1}}))
+	entry := newTestEntry(100, 3)
+	neighbors := []neighborCell{
+		newTestNeighbor(1, 100, 3),
+		newTestNeighbor(2, 100, 4),
+	}
+
+	assert.Equal(t, int32(5), pciCtrl.selectPci(entry, []int32{7, 8, 5}, neighbors))
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+)
+
+// OscillationAlarm is raised when the controller changed the PCI of a cell too many times within the oscillation
+// window; the cell is frozen until it is unfrozen by the operator
+type OscillationAlarm struct {
+	CellID uint64
+	Time   time.Time
+	// Changes are the times of the PCI changes within the window
+	Changes []time.Time
+}
+
+// stabilizer keeps the controller from reacting to the indication messages reporting its own PCI changes,
+// and freezes the cells whose PCI oscillates
+type stabilizer struct {
+	cellWindow        time.Duration
+	clusterWindow     time.Duration
+	oscillationLimit  int
+	oscillationWindow time.Duration
+
+	// cells and clusters are settling until the end of their window after the last change
+	cells    map[uint64]time.Time
+	clusters map[string]time.Time
+	// changes are the times of the recent changes of each cell
+	changes map[uint64][]time.Time
+	frozen  map[uint64]OscillationAlarm
+	mu      sync.Mutex
+}
+
+func newStabilizer(options Options) *stabilizer {
+	return &stabilizer{
+		cellWindow:        options.CellSettleWindow,
+		clusterWindow:     options.ClusterSettleWindow,
+		oscillationLimit:  options.OscillationLimit,
+		oscillationWindow: options.OscillationWindow,
+		cells:             make(map[uint64]time.Time),
+		clusters:          make(map[string]time.Time),
+		changes:           make(map[uint64][]time.Time),
+		frozen:            make(map[uint64]OscillationAlarm),
+	}
+}
+
+// recordChange starts the settle windows of a cell and its cluster after a change made by the controller,
+// and freezes the cell if it is changed too often; it returns a function undoing it if the change fails
+func (s *stabilizer) recordChange(key uint64, cluster string, now time.Time) func() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	undo := s.restorer(key, cluster)
+	s.cells[key] = now.Add(s.cellWindow)
+	if cluster != "" && s.clusterWindow > 0 {
+		s.clusters[cluster] = now.Add(s.clusterWindow)
+	}
+	if s.oscillationLimit <= 0 {
+		return undo
+	}
+
+	changes := []time.Time{now}
+	for _, t := range s.changes[key] {
+		if now.Sub(t) < s.oscillationWindow {
+			changes = append(changes, t)
+		}
+	}
+	s.changes[key] = changes
+	if len(changes) >= s.oscillationLimit {
+		delete(s.changes, key)
+		s.frozen[key] = OscillationAlarm{CellID: key, Time: now, Changes: changes}
+		log.Errorf("PCI of cell %v changed %v times within %v: the cell is frozen until it is unfrozen",
+			key, len(changes), s.oscillationWindow)
+	}
+	return undo
+}
+
+// restorer returns a function setting the state of a cell and its cluster back to their current state
+func (s *stabilizer) restorer(key uint64, cluster string) func() {
+	cellUntil, settling := s.cells[key]
+	clusterUntil, clusterSettling := s.clusters[cluster]
+	changes, changed := s.changes[key]
+	alarm, frozen := s.frozen[key]
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.cells, key)
+		if settling {
+			s.cells[key] = cellUntil
+		}
+		delete(s.clusters, cluster)
+		if clusterSettling {
+			s.clusters[cluster] = clusterUntil
+		}
+		delete(s.changes, key)
+		if changed {
+			s.changes[key] = changes
+		}
+		delete(s.frozen, key)
+		if frozen {
+			s.frozen[key] = alarm
+		}
+	}
+}
+
+// settling checks if a cell or its cluster was changed by the controller within the settle window
+func (s *stabilizer) settling(key uint64, cluster string, now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if until, ok := s.cells[key]; ok {
+		if now.Before(until) {
+			return true
+		}
+		delete(s.cells, key)
+	}
+	if until, ok := s.clusters[cluster]; ok && cluster != "" {
+		if now.Before(until) {
+			return true
+		}
+		delete(s.clusters, cluster)
+	}
+	return false
+}
+
+// forget drops the settle window, the recent changes and the oscillation alarm of a cell
+func (s *stabilizer) forget(key uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.cells, key)
+	delete(s.changes, key)
+	if _, ok := s.frozen[key]; ok {
+		delete(s.frozen, key)
+		log.Infof("Cell %v removed: its oscillation alarm is dropped", key)
+	}
+}
+
+func (s *stabilizer) isFrozen(key uint64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.frozen[key]
+	return ok
+}
+
+// ListFrozenCells lists the oscillation alarms of the frozen cells, ordered by cell ID
+func (p *PciController) ListFrozenCells(_ context.Context) []OscillationAlarm {
+	p.stabilizer.mu.Lock()
+	defer p.stabilizer.mu.Unlock()
+	alarms := make([]OscillationAlarm, 0, len(p.stabilizer.frozen))
+	for _, alarm := range p.stabilizer.frozen {
+		alarms = append(alarms, alarm)
+	}
+	sort.Slice(alarms, func(i, j int) bool {
+		return alarms[i].CellID < alarms[j].CellID
+	})
+	return alarms
+}
+
+// UnfreezeCell lets the controller change the PCI of a frozen cell again
+func (p *PciController) UnfreezeCell(_ context.Context, key uint64) error {
+	p.stabilizer.mu.Lock()
+	defer p.stabilizer.mu.Unlock()
+	if _, ok := p.stabilizer.frozen[key]; !ok {
+		return errors.NewNotFound("cell %v is not frozen", key)
+	}
+	delete(p.stabilizer.frozen, key)
+	log.Infof("Cell %v unfrozen", key)
+	return nil
+}
+
+// forgetDeletedCells drops the stabilizer state of the cells removed from the store; the store is watched
+// before it returns, so that no removal is missed
+func (p *PciController) forgetDeletedCells(ctx context.Context) error {
+	ch := make(chan metrics.Event)
+	if err := p.metricStore.Watch(ctx, ch, metrics.WithEventTypes(metrics.Deleted)); err != nil {
+		return err
+	}
+	go func() {
+		for e := range ch {
+			p.stabilizer.forget(e.Key)
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOscillationFreeze(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2, 3}},
+		{nci: 2, arfcn: 100, pci: 1, neighbors: []byte{1}},
+		{nci: 3, arfcn: 100, pci: 2, neighbors: []byte{1}},
+	})
+	pciCtrl := NewPciController(store, WithOscillationDetection(2, time.Minute))
+	macroKey, smallKey := metrics.NewKey(testCGI(1)), metrics.NewKey(testCGI(2))
+
+	// the small cell keeps reporting the colliding PCI
+	resolve := func() error {
+		assert.NoError(t, store.UpdatePci(ctx, smallKey, 1, 0))
+		small, err := store.Get(ctx, smallKey)
+		assert.NoError(t, err)
+		return pciCtrl.changeLeastDisruptive(ctx, pciCtrl.getConflictingEntries(ctx, small))
+	}
+	assert.NoError(t, resolve())
+	assert.True(t, pciCtrl.stabilizer.settling(smallKey, "", time.Now()))
+	assert.False(t, pciCtrl.stabilizer.settling(macroKey, "", time.Now()))
+	assert.Empty(t, pciCtrl.ListFrozenCells(ctx))
+
+	assert.NoError(t, resolve())
+	alarms := pciCtrl.ListFrozenCells(ctx)
+	assert.Len(t, alarms, 1)
+	assert.Equal(t, smallKey, alarms[0].CellID)
+	assert.Len(t, alarms[0].Changes, 2)
+
+	// the frozen cell keeps its PCI like a locked one
+	assert.NoError(t, resolve())
+	macro, err := store.Get(ctx, macroKey)
+	assert.NoError(t, err)
+	assert.NotEqual(t, int32(1), macro.Value.Metric.PCI)
+
+	assert.NoError(t, pciCtrl.UnfreezeCell(ctx, smallKey))
+	assert.Empty(t, pciCtrl.ListFrozenCells(ctx))
+	assert.True(t, errors.IsNotFound(pciCtrl.UnfreezeCell(ctx, smallKey)))
+}
+
+func TestSettleWindows(t *testing.T) {
+	now := time.Now()
+	s := newStabilizer(Options{CellSettleWindow: time.Minute, ClusterSettleWindow: time.Second})
+	s.recordChange(1, "cluster", now)
+	assert.True(t, s.settling(1, "", now.Add(30*time.Second)))
+	assert.True(t, s.settling(2, "cluster", now))
+	assert.False(t, s.settling(2, "cluster", now.Add(2*time.Second)))
+	assert.False(t, s.settling(2, "", now))
+	assert.False(t, s.settling(1, "cluster", now.Add(2*time.Minute)))
+	assert.False(t, s.isFrozen(1))
+}
+
+func TestForgetDeletedCells(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1},
+		{nci: 2, arfcn: 100, pci: 2},
+	})
+	pciCtrl := NewPciController(store, WithSettleWindows(time.Minute, 0), WithOscillationDetection(1, time.Minute))
+	assert.NoError(t, pciCtrl.forgetDeletedCells(ctx))
+	key1, key2 := metrics.NewKey(testCGI(1)), metrics.NewKey(testCGI(2))
+	now := time.Now()
+	pciCtrl.stabilizer.recordChange(key1, "", now)
+	pciCtrl.stabilizer.recordChange(key2, "", now)
+	assert.Len(t, pciCtrl.ListFrozenCells(ctx), 2)
+
+	assert.NoError(t, store.Delete(ctx, key1))
+	assert.Eventually(t, func() bool {
+		return len(pciCtrl.ListFrozenCells(ctx)) == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, key2, pciCtrl.ListFrozenCells(ctx)[0].CellID)
+	assert.False(t, pciCtrl.stabilizer.settling(key1, "", now))
+	assert.True(t, pciCtrl.stabilizer.settling(key2, "", now))
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+)
+
+// isIgnored checks if a cell in store is ignored as a neighbor: a stale cell does not occupy its PCI unless configured,
+// so that a cell which stopped reporting does not block its PCI forever
+func (p *PciController) isIgnored(entry *metrics.Entry) bool {
+	return entry.Value.Stale && !p.staleOccupiesPci
+}
+
+// expireStaleEntries marks the cells without indication messages within the TTL as stale, or removes them
+func (p *PciController) expireStaleEntries(ctx context.Context) {
+	keys, err := p.metricStore.ExpireEntries(ctx, time.Now().Add(-p.staleTTL), p.evictStale)
+	if err != nil {
+		log.Warn(err)
+		return
+	}
+	if len(keys) > 0 {
+		log.Infof("Cells %v did not send indication messages for %v (evicted: %v)", keys, p.staleTTL, p.evictStale)
+	}
+}
+
+// runStaleExpiry checks the stale cells periodically
+func (p *PciController) runStaleExpiry(ctx context.Context) {
+	ticker := time.NewTicker(checkInterval(p.staleTTL))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.expireStaleEntries(ctx)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaleNeighbor(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1, neighbors: []byte{2}},
+		{nci: 2, arfcn: 100, pci: 2, neighbors: []byte{1}},
+	})
+	key := metrics.NewKey(testCGI(1))
+	neighborKey := metrics.NewKey(testCGI(2))
+	neighbor, err := store.Get(ctx, neighborKey)
+	assert.NoError(t, err)
+	neighbor.Value.Stale = true
+	assert.NoError(t, store.Update(ctx, neighborKey, neighbor))
+
+	occupying := NewPciController(store, WithStaleOccupiesPci(true))
+	assert.True(t, errors.IsConflict(occupying.validatePci(ctx, key, 2)))
+
+	// a stale neighbor does not occupy its PCI by default
+	pciCtrl := NewPciController(store)
+	_, err = pciCtrl.SetPci(ctx, key, 2)
+	assert.NoError(t, err)
+	entry, err := store.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Empty(t, pciCtrl.DetectConflicts(ctx, entry))
+	assert.Len(t, occupying.DetectConflicts(ctx, entry), 1)
+}
+
+func TestStaleExpiry(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1},
+	})
+
+	// a TTL shorter than the minimum check interval is checked at that interval
+	pciCtrl := NewPciController(store, WithStaleTTL(time.Nanosecond, false))
+	go pciCtrl.runStaleExpiry(ctx)
+	assert.Eventually(t, func() bool {
+		entry, err := store.Get(ctx, metrics.NewKey(testCGI(1)))
+		return err == nil && entry.Value.Stale
+	}, time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/onosproject/onos-pci/pkg/store/history"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+)
+
+// VerificationStats are the numbers of PCI changes by verification
+type VerificationStats struct {
+	// Pending changes were sent to the E2 nodes and wait for the next indication message of their cell
+	Pending   int
+	Confirmed uint64
+	// Reverted changes were not reported by the next indication message
+	Reverted uint64
+	// TimedOut changes were not followed by an indication message within the verification timeout
+	TimedOut uint64
+}
+
+// inFlightChange is a PCI change sent to the E2 node and not verified yet
+type inFlightChange struct {
+	pci      int32
+	acked    time.Time
+	deadline time.Time
+}
+
+// verifier tracks the PCI changes sent to the E2 nodes until an indication message confirms them
+type verifier struct {
+	timeout time.Duration
+	changes map[uint64]inFlightChange
+	stats   VerificationStats
+	mu      sync.Mutex
+}
+
+func newVerifier(timeout time.Duration) *verifier {
+	if timeout <= 0 {
+		timeout = DefaultVerificationTimeout
+	}
+	return &verifier{
+		timeout: timeout,
+		changes: make(map[uint64]inFlightChange),
+	}
+}
+
+// track starts tracking the change of a cell to a PCI acknowledged by the E2 node; an unverified earlier
+// change of the cell is replaced
+func (v *verifier) track(key uint64, pci int32, acked time.Time, now time.Time) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.changes[key] = inFlightChange{pci: pci, acked: acked, deadline: now.Add(v.timeout)}
+}
+
+// verify checks the PCI reported by an indication message against the change of the cell, if any; an
+// indication message that arrived before the control acknowledgement was in flight and does not verify it
+func (v *verifier) verify(key uint64, reportedPCI int32, updated time.Time) (int32, history.Verification) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	change, ok := v.changes[key]
+	if !ok || updated.Before(change.acked) {
+		return 0, history.NotVerified
+	}
+	delete(v.changes, key)
+	if change.pci == reportedPCI {
+		v.stats.Confirmed++
+		return change.pci, history.Confirmed
+	}
+	v.stats.Reverted++
+	return change.pci, history.RevertedByNode
+}
+
+// expire stops tracking the changes not verified before their deadline, and returns them
+func (v *verifier) expire(now time.Time) map[uint64]int32 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	expired := make(map[uint64]int32)
+	for key, change := range v.changes {
+		if now.After(change.deadline) {
+			expired[key] = change.pci
+			delete(v.changes, key)
+			v.stats.TimedOut++
+		}
+	}
+	return expired
+}
+
+func (v *verifier) getStats() VerificationStats {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	stats := v.stats
+	stats.Pending = len(v.changes)
+	return stats
+}
+
+// verifyChanges starts verifying the PCI changes whose control message succeeded against the next indication
+// message of their cell; the store is watched before it returns, so that no control outcome is missed
+func (p *PciController) verifyChanges(ctx context.Context) error {
+	ch := make(chan metrics.Event)
+	if err := p.metricStore.Watch(ctx, ch, metrics.WithEventTypes(metrics.Created, metrics.ControlResult)); err != nil {
+		return err
+	}
+	go func() {
+		ticker := time.NewTicker(checkInterval(p.verifier.timeout))
+		defer ticker.Stop()
+		for {
+			select {
+			case e, ok := <-ch:
+				if !ok {
+					return
+				}
+				p.handleVerificationEvent(ctx, e)
+			case now := <-ticker.C:
+				for key, pci := range p.verifier.expire(now) {
+					log.Warnf("PCI %v of cell %v was not confirmed by an indication message within %v", pci, key, p.verifier.timeout)
+					p.setVerification(ctx, key, pci, history.TimedOut, 0)
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+func (p *PciController) handleVerificationEvent(ctx context.Context, e metrics.Event) {
+	switch e.Type {
+	case metrics.ControlResult:
+		result := e.Value.Value.LastControl
+		if result != nil && result.Failure == types.NoFailure && result.Error == "" {
+			p.verifier.track(e.Key, result.PCI, result.Acked, time.Now())
+		}
+	case metrics.Created:
+		reportedPCI := e.Value.Value.Metric.PCI
+		pci, verification := p.verifier.verify(e.Key, reportedPCI, e.Value.Value.LastUpdated)
+		switch verification {
+		case history.Confirmed:
+			log.Infof("PCI %v of cell %v confirmed by an indication message", pci, e.Key)
+		case history.RevertedByNode:
+			log.Warnf("PCI %v of cell %v reverted by the E2 node: the indication message reported PCI %v", pci, e.Key, reportedPCI)
+		default:
+			return
+		}
+		p.setVerification(ctx, e.Key, pci, verification, reportedPCI)
+	}
+}
+
+func (p *PciController) setVerification(ctx context.Context, key uint64, pci int32, verification history.Verification, reportedPCI int32) {
+	if err := p.historyStore.SetVerification(ctx, key, pci, verification, reportedPCI); err != nil {
+		log.Debug(err)
+	}
+}
+
+// GetVerificationStats gets the numbers of pending, confirmed, reverted and timed out PCI changes
+func (p *PciController) GetVerificationStats() VerificationStats {
+	return p.verifier.getStats()
+}
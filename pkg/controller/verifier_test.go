@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-pci/pkg/store/history"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyChanges(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	putTestCells(t, store, []testCell{
+		{nci: 1, arfcn: 100, pci: 1},
+		{nci: 2, arfcn: 100, pci: 2},
+	})
+	pciCtrl := NewPciController(store, WithVerificationTimeout(100*time.Millisecond))
+	assert.NoError(t, pciCtrl.verifyChanges(ctx))
+	key1, key2 := metrics.NewKey(testCGI(1)), metrics.NewKey(testCGI(2))
+
+	// an indication message before the control outcome is not a verification
+	_, err := pciCtrl.SetPci(ctx, key1, 3)
+	assert.NoError(t, err)
+	putTestCells(t, store, []testCell{{nci: 1, arfcn: 100, pci: 1}})
+	assert.NoError(t, store.SetControlResult(ctx, key1, types.ControlResult{PCI: 3}))
+	putTestCells(t, store, []testCell{{nci: 1, arfcn: 100, pci: 3}})
+	_, err = pciCtrl.SetPci(ctx, key2, 4)
+	assert.NoError(t, err)
+	assert.NoError(t, store.SetControlResult(ctx, key2, types.ControlResult{PCI: 4}))
+	putTestCells(t, store, []testCell{{nci: 2, arfcn: 100, pci: 2}})
+	assert.Eventually(t, func() bool {
+		stats := pciCtrl.GetVerificationStats()
+		return stats.Confirmed == 1 && stats.Reverted == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// a failed control message is not verified
+	_, err = pciCtrl.SetPci(ctx, key1, 5)
+	assert.NoError(t, err)
+	assert.NoError(t, store.SetControlResult(ctx, key1, types.ControlResult{PCI: 5, Error: "rejected", Failure: types.RICControlFailure}))
+	_, err = pciCtrl.SetPci(ctx, key1, 6)
+	assert.NoError(t, err)
+	assert.NoError(t, store.SetControlResult(ctx, key1, types.ControlResult{PCI: 6}))
+	assert.Eventually(t, func() bool {
+		return pciCtrl.GetVerificationStats().TimedOut == 1
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 0, pciCtrl.GetVerificationStats().Pending)
+
+	records, err := pciCtrl.ListHistory(ctx, key1, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, records, 3)
+	assert.Equal(t, history.Confirmed, records[0].Verification)
+	assert.Equal(t, history.NotVerified, records[1].Verification)
+	assert.Equal(t, history.TimedOut, records[2].Verification)
+	records, err = pciCtrl.ListHistory(ctx, key2, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, history.RevertedByNode, records[0].Verification)
+	assert.Equal(t, int32(2), records[0].ReportedPCI)
+}
+
+func TestVerifyInFlightIndication(t *testing.T) {
+	v := newVerifier(time.Second)
+	acked := time.Now()
+	v.track(1, 4, acked, acked)
+
+	// the indication message sent before the control acknowledgement still reports the old PCI
+	_, verification := v.verify(1, 2, acked.Add(-time.Millisecond))
+	assert.Equal(t, history.NotVerified, verification)
+	assert.Equal(t, 1, v.getStats().Pending)
+
+	pci, verification := v.verify(1, 4, acked.Add(time.Millisecond))
+	assert.Equal(t, int32(4), pci)
+	assert.Equal(t, history.Confirmed, verification)
+	assert.Equal(t, VerificationStats{Confirmed: 1}, v.getStats())
+}
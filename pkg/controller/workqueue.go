@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"sync"
+)
+
+// workItem is the pending work of a cell
+type workItem struct {
+	key uint64
+	// expand re-checks the cells within the search depth of the cell too
+	expand bool
+}
+
+// workQueue is a queue of cells whose conflicts have to be re-checked. The events of a cell queued before its work
+// is started are coalesced into one item, and a cell is never processed by two workers at once: an event arriving
+// while the cell is processed queues it again once it is done.
+type workQueue struct {
+	// queue are the keys of the items ready to be processed, in order
+	queue []uint64
+	// pending are the items not started yet, including those of the cells being processed
+	pending    map[uint64]*workItem
+	processing map[uint64]bool
+	shutdown   bool
+	mu         sync.Mutex
+	cond       *sync.Cond
+}
+
+func newWorkQueue() *workQueue {
+	q := &workQueue{
+		pending:    make(map[uint64]*workItem),
+		processing: make(map[uint64]bool),
+	}
+	q.cond = sync.NewCond(&q.mu)
+	return q
+}
+
+// add queues the work of a cell, or merges it into the work of the cell not started yet
+func (q *workQueue) add(key uint64, expand bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.shutdown {
+		return
+	}
+	if item, ok := q.pending[key]; ok {
+		item.expand = item.expand || expand
+		return
+	}
+	q.pending[key] = &workItem{key: key, expand: expand}
+	if !q.processing[key] {
+		q.queue = append(q.queue, key)
+		q.cond.Signal()
+	}
+}
+
+// get waits for the next item and starts processing it; it returns false once the queue is shut down
+func (q *workQueue) get() (workItem, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for len(q.queue) == 0 && !q.shutdown {
+		q.cond.Wait()
+	}
+	if q.shutdown {
+		return workItem{}, false
+	}
+	key := q.queue[0]
+	q.queue = q.queue[1:]
+	item := q.pending[key]
+	delete(q.pending, key)
+	q.processing[key] = true
+	return *item, true
+}
+
+// done ends the processing of a cell, and queues it again if an event arrived meanwhile
+func (q *workQueue) done(key uint64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.processing, key)
+	if _, ok := q.pending[key]; ok && !q.shutdown {
+		q.queue = append(q.queue, key)
+		q.cond.Signal()
+	}
+}
+
+// shutDown stops the workers waiting for items; the items not started are dropped
+func (q *workQueue) shutDown() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.shutdown = true
+	q.cond.Broadcast()
+}
+
+// neighborhoodLocks serializes the work on overlapping neighborhoods, so that two workers do not pick the same PCI
+// for cells within the search depth of each other
+type neighborhoodLocks struct {
+	locked map[uint64]bool
+	mu     sync.Mutex
+	cond   *sync.Cond
+}
+
+func newNeighborhoodLocks() *neighborhoodLocks {
+	l := &neighborhoodLocks{
+		locked: make(map[uint64]bool),
+	}
+	l.cond = sync.NewCond(&l.mu)
+	return l
+}
+
+// lock waits until none of the cells is locked, then locks all of them at once
+func (l *neighborhoodLocks) lock(keys []uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for l.anyLocked(keys) {
+		l.cond.Wait()
+	}
+	for _, key := range keys {
+		l.locked[key] = true
+	}
+}
+
+func (l *neighborhoodLocks) unlock(keys []uint64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, key := range keys {
+		delete(l.locked, key)
+	}
+	l.cond.Broadcast()
+}
+
+func (l *neighborhoodLocks) anyLocked(keys []uint64) bool {
+	for _, key := range keys {
+		if l.locked[key] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkQueue(t *testing.T) {
+	q := newWorkQueue()
+	q.add(1, false)
+	q.add(2, false)
+	q.add(1, true)
+
+	// the events of cell 1 are coalesced
+	item, ok := q.get()
+	assert.True(t, ok)
+	assert.Equal(t, workItem{key: 1, expand: true}, item)
+
+	// an event of cell 1 while it is processed is only started once it is done
+	q.add(1, false)
+	item, ok = q.get()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), item.key)
+	q.done(2)
+	got := make(chan workItem)
+	go func() {
+		item, ok := q.get()
+		if ok {
+			got <- item
+		}
+	}()
+	select {
+	case <-got:
+		assert.Fail(t, "cell 1 processed twice at once")
+	case <-time.After(50 * time.Millisecond):
+	}
+	q.done(1)
+	assert.Equal(t, workItem{key: 1}, <-got)
+
+	q.shutDown()
+	_, ok = q.get()
+	assert.False(t, ok)
+}
+
+func TestNeighborhoodLocks(t *testing.T) {
+	l := newNeighborhoodLocks()
+	l.lock([]uint64{1, 2, 3})
+	// disjoint neighborhoods are locked in parallel
+	l.lock([]uint64{4, 5})
+
+	locked := make(chan struct{})
+	go func() {
+		l.lock([]uint64{5, 6})
+		close(locked)
+	}()
+	l.unlock([]uint64{1, 2, 3})
+	select {
+	case <-locked:
+		assert.Fail(t, "overlapping neighborhoods locked at once")
+	case <-time.After(50 * time.Millisecond):
+	}
+	l.unlock([]uint64{4, 5})
+	<-locked
+}
@@ -7,6 +7,7 @@ package manager
 
 import (
 	"context"
+	"time"
 
 	"github.com/onosproject/onos-pci/pkg/northbound"
 
@@ -16,7 +17,11 @@ import (
 	appConfig "github.com/onosproject/onos-pci/pkg/config"
 	"github.com/onosproject/onos-pci/pkg/controller"
 	"github.com/onosproject/onos-pci/pkg/southbound/e2"
+	"github.com/onosproject/onos-pci/pkg/store/history"
 	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/store/reserved"
+	"github.com/onosproject/onos-pci/pkg/utils"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
 	app "github.com/onosproject/onos-ric-sdk-go/pkg/config/app/default"
 )
 
@@ -33,6 +38,11 @@ type Config struct {
 	AppConfig   *app.Config
 	SMName      string
 	SMVersion   string
+	// StorePath is the path to the file persisting the metrics store; the store is in memory only if empty
+	StorePath string
+	// StoreReloadTTL is the time within which the cells reloaded from the metrics store file have to send an
+	// indication message before they are removed; metrics.DefaultReloadTTL if not positive
+	StoreReloadTTL time.Duration
 }
 
 // NewManager creates a new manager
@@ -42,7 +52,7 @@ func NewManager(config Config) *Manager {
 		log.Warn(err)
 	}
 	subscriptionBroker := broker.NewBroker()
-	metricStore := metrics.NewStore()
+	metricStore := newMetricStore(config.StorePath, config.StoreReloadTTL)
 
 	e2Manager, err := e2.NewManager(
 		e2.WithE2TAddress("onos-e2t", 5150),
@@ -51,27 +61,163 @@ func NewManager(config Config) *Manager {
 		e2.WithAppConfig(appCfg),
 		e2.WithAppID("onos-pci"),
 		e2.WithBroker(subscriptionBroker),
-		e2.WithMetricStore(metricStore))
+		e2.WithMetricStore(metricStore),
+		e2ControlOption(appCfg))
 
 	if err != nil {
 		log.Warn(err)
 	}
 
+	reservedStore := newReservedStore(appCfg)
+	pciCtrlOptions := append(pciControllerOptions(appCfg), controller.WithReservedStore(reservedStore))
+
 	manager := &Manager{
-		appConfig: appCfg,
-		config:    config,
-		e2Manager: e2Manager,
-		pciCtrl:   controller.NewPciController(metricStore),
+		appConfig:     appCfg,
+		config:        config,
+		e2Manager:     e2Manager,
+		reservedStore: reservedStore,
+		pciCtrl:       controller.NewPciController(metricStore, pciCtrlOptions...),
 	}
 	return manager
 }
 
+// newMetricStore creates the metrics store, persisted in a file if a path is given
+func newMetricStore(path string, reloadTTL time.Duration) metrics.Store {
+	if path == "" {
+		return metrics.NewStore()
+	}
+	metricStore, err := metrics.NewPersistentStore(path, reloadTTL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return metricStore
+}
+
+// newReservedStore creates the reserved PCI store with the reserved PCIs in the app config
+func newReservedStore(appCfg *appConfig.AppConfig) reserved.Store {
+	if appCfg == nil {
+		return reserved.NewStore()
+	}
+	reservedConfig, err := appCfg.GetReservedPCIConfig(utils.ReservedPCIsConfigPath)
+	if err != nil {
+		log.Infof("No reserved PCIs: %v", err)
+		return reserved.NewStore()
+	}
+	reservedStore, err := reserved.NewStoreWithConfig(reservedConfig)
+	if err != nil {
+		log.Warnf("Ignoring reserved PCIs: %v", err)
+		return reserved.NewStore()
+	}
+	return reservedStore
+}
+
+// pciControllerOptions creates the PCI controller options from the app config
+func pciControllerOptions(appCfg *appConfig.AppConfig) []controller.Option {
+	opts := make([]controller.Option, 0)
+	if appCfg == nil {
+		return opts
+	}
+
+	nrModRules, err := appCfg.GetModRules(utils.NRModRulesConfigPath)
+	if err != nil {
+		log.Infof("Using default NR PCI mod rules: %v", err)
+	} else {
+		opts = append(opts, controller.WithModRules(parse.CGITypeNrCGI, nrModRules))
+	}
+	eutraModRules, err := appCfg.GetModRules(utils.EUTRAModRulesConfigPath)
+	if err != nil {
+		log.Infof("Using default EUTRA PCI mod rules: %v", err)
+	} else {
+		opts = append(opts, controller.WithModRules(parse.CGITypeECGI, eutraModRules))
+	}
+
+	if depth, err := appCfg.GetUint64WithPath(utils.SearchDepthConfigPath); err == nil {
+		if depth == 0 {
+			log.Warn("PCI search depth should be greater than 0; using the default")
+		} else {
+			opts = append(opts, controller.WithSearchDepth(int(depth)))
+		}
+	}
+	if nrBandwidths, err := appCfg.GetBandwidths(utils.NRBandwidthsConfigPath); err == nil {
+		opts = append(opts, controller.WithBandwidths(parse.CGITypeNrCGI, nrBandwidths))
+	}
+	if eutraBandwidths, err := appCfg.GetBandwidths(utils.EUTRABandwidthsConfigPath); err == nil {
+		opts = append(opts, controller.WithBandwidths(parse.CGITypeECGI, eutraBandwidths))
+	}
+
+	if modeName, err := appCfg.GetStringWithPath(utils.ModeConfigPath); err == nil {
+		mode, err := controller.ParseMode(modeName)
+		if err != nil {
+			log.Warn(err)
+		} else {
+			opts = append(opts, controller.WithMode(mode))
+		}
+	}
+	if interval, err := appCfg.GetUint64WithPath(utils.OptimizationIntervalConfigPath); err == nil && interval > 0 {
+		opts = append(opts, controller.WithOptimizationInterval(time.Duration(interval)*time.Second))
+	}
+	if dryRun, err := appCfg.GetBoolWithPath(utils.DryRunConfigPath); err == nil {
+		opts = append(opts, controller.WithDryRun(dryRun))
+	}
+	if expiry, err := appCfg.GetUint64WithPath(utils.ProposalExpiryConfigPath); err == nil && expiry > 0 {
+		opts = append(opts, controller.WithProposalExpiry(time.Duration(expiry)*time.Second))
+	}
+	if limit, err := appCfg.GetUint64WithPath(utils.HistoryLimitConfigPath); err == nil && limit > 0 {
+		opts = append(opts, controller.WithHistoryStore(history.NewStore(int(limit))))
+	}
+	if ttl, err := appCfg.GetUint64WithPath(utils.StaleTTLConfigPath); err == nil && ttl > 0 {
+		evict, _ := appCfg.GetBoolWithPath(utils.StaleEvictConfigPath)
+		opts = append(opts, controller.WithStaleTTL(time.Duration(ttl)*time.Second, evict))
+	}
+	if occupy, err := appCfg.GetBoolWithPath(utils.StaleOccupyConfigPath); err == nil {
+		opts = append(opts, controller.WithStaleOccupiesPci(occupy))
+	}
+	if timeout, err := appCfg.GetUint64WithPath(utils.VerificationTimeoutConfigPath); err == nil && timeout > 0 {
+		opts = append(opts, controller.WithVerificationTimeout(time.Duration(timeout)*time.Second))
+	}
+	cell, cluster := controller.DefaultSettleWindow, time.Duration(0)
+	if value, err := appCfg.GetUint64WithPath(utils.CellSettleConfigPath); err == nil {
+		cell = time.Duration(value) * time.Second
+	}
+	if value, err := appCfg.GetUint64WithPath(utils.ClusterSettleConfigPath); err == nil {
+		cluster = time.Duration(value) * time.Second
+	}
+	opts = append(opts, controller.WithSettleWindows(cell, cluster))
+	changes, window := controller.DefaultOscillationLimit, controller.DefaultOscillationWindow
+	if value, err := appCfg.GetUint64WithPath(utils.OscillationChangesConfigPath); err == nil {
+		changes = int(value)
+	}
+	if value, err := appCfg.GetUint64WithPath(utils.OscillationWindowConfigPath); err == nil && value > 0 {
+		window = time.Duration(value) * time.Second
+	}
+	opts = append(opts, controller.WithOscillationDetection(changes, window))
+	if workers, err := appCfg.GetUint64WithPath(utils.WorkersConfigPath); err == nil && workers > 0 {
+		opts = append(opts, controller.WithWorkers(int(workers)))
+	}
+	return opts
+}
+
+// e2ControlOption returns the retry option of the RC control messages from the app config
+func e2ControlOption(appCfg *appConfig.AppConfig) e2.Option {
+	retries, backoff := e2.DefaultControlRetries, e2.DefaultControlBackoff
+	if appCfg != nil {
+		if value, err := appCfg.GetUint64WithPath(utils.ControlRetriesConfigPath); err == nil {
+			retries = int(value)
+		}
+		if value, err := appCfg.GetUint64WithPath(utils.ControlBackoffConfigPath); err == nil && value > 0 {
+			backoff = time.Duration(value) * time.Millisecond
+		}
+	}
+	return e2.WithControlRetry(retries, backoff)
+}
+
 // Manager is a manager for the PCI xAPP service
 type Manager struct {
-	appConfig appConfig.Config
-	config    Config
-	e2Manager e2.Manager
-	pciCtrl   controller.PciController
+	appConfig     appConfig.Config
+	config        Config
+	e2Manager     e2.Manager
+	reservedStore reserved.Store
+	pciCtrl       controller.PciController
 }
 
 // Run starts the manager and the associated services
@@ -104,6 +250,9 @@ func (m *Manager) Start() error {
 // Close kills the channels and manager related objects
 func (m *Manager) Close() {
 	log.Info("Closing Manager")
+	if err := m.GetMetricsStore().Close(); err != nil {
+		log.Warn(err)
+	}
 }
 
 func (m *Manager) startNorthboundServer() error {
@@ -115,7 +264,7 @@ func (m *Manager) startNorthboundServer() error {
 		true,
 		nblib.SecurityConfig{}))
 
-	s.AddService(northbound.NewService(m.GetMetricsStore()))
+	s.AddService(northbound.NewService(m.GetMetricsStore(), m.reservedStore, &m.pciCtrl))
 
 	doneCh := make(chan error)
 	go func() {
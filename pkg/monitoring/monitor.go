@@ -7,19 +7,17 @@ package monitoring
 
 import (
 	"context"
-	"fmt"
 	e2api "github.com/onosproject/onos-api/go/onos/e2t/e2/v1beta1"
 	topoapi "github.com/onosproject/onos-api/go/onos/topo"
 	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	"github.com/onosproject/onos-pci/pkg/broker"
 	appConfig "github.com/onosproject/onos-pci/pkg/config"
+	"github.com/onosproject/onos-pci/pkg/pools"
 	"github.com/onosproject/onos-pci/pkg/rnib"
 	"github.com/onosproject/onos-pci/pkg/store/metrics"
 	"github.com/onosproject/onos-pci/pkg/types"
-	"github.com/onosproject/onos-pci/pkg/utils/parse"
 	"google.golang.org/protobuf/proto"
-	"strconv"
 )
 
 var log = logging.GetLogger()
@@ -31,12 +29,17 @@ func NewMonitor(opts ...Option) *Monitor {
 	for _, opt := range opts {
 		opt.apply(&options)
 	}
+	pciPools := options.App.PCIPools
+	if pciPools == nil {
+		pciPools = &pools.Provider{}
+	}
 	return &Monitor{
 		streamReader: options.Monitor.StreamReader,
 		appConfig:    options.App.AppConfig,
 		metricStore:  options.App.MetricStore,
 		nodeID:       options.Monitor.NodeID,
 		rnibClient:   options.App.RNIBClient,
+		pciPools:     pciPools,
 	}
 }
 
@@ -47,6 +50,7 @@ type Monitor struct {
 	metricStore  metrics.Store
 	nodeID       topoapi.ID
 	rnibClient   rnib.Client
+	pciPools     *pools.Provider
 }
 
 func (m *Monitor) processIndicationFormat3(ctx context.Context, indication e2api.Indication, nodeID topoapi.ID) error {
@@ -68,59 +72,71 @@ func (m *Monitor) processIndicationFormat3(ctx context.Context, indication e2api
 	log.Debugf("Indication header format 1 %v", headerFormat1)
 	log.Debugf("Indication message format 3 %v", messageFormat3)
 
-	var pciPoolList []*types.PCIPool
-	pciPool := &types.PCIPool{
-		LowerPci: types.LowerPCI,
-		UpperPci: types.UpperPCI,
-	}
-	pciPoolList = append(pciPoolList, pciPool)
-
 	for _, cellInfo := range messageFormat3.GetCellInfoList() {
-		if cellInfo.GetCellGlobalId().GetNRCgi() != nil {
+		cgi := cellInfo.GetCellGlobalId()
+		nrt := cellInfo.GetNeighborRelationTable()
+		var pci, arfcn int32
+		if cgi.GetNRCgi() != nil {
 			// 5G case
-			cgi := cellInfo.GetCellGlobalId()
-			if cellInfo.GetNeighborRelationTable().GetServingCellPci().GetNR() == nil {
+			if nrt.GetServingCellPci().GetNR() == nil {
 				log.Errorf("PCI should be NR PCI but NR PCI field is empty in E2 Indication message")
 				continue
 			}
-			pci := cellInfo.GetNeighborRelationTable().GetServingCellPci().GetNR().GetValue()
-			if cellInfo.GetNeighborRelationTable().GetServingCellArfcn().GetNR() == nil {
+			if nrt.GetServingCellArfcn().GetNR() == nil {
 				log.Errorf("ARFCN should be NR ARFCN but NR ARFCN field is empty in E2 indication message")
 				continue
 			}
-			arfcn := cellInfo.GetNeighborRelationTable().GetServingCellArfcn().GetNR()
-			key := metrics.NewKey(cgi)
-			_, err := m.metricStore.Put(ctx, key, metrics.Entry{
-				Key: metrics.Key{
-					CellGlobalID: cgi,
-				},
-				Value: types.CellPCI{
-					E2NodeID: nodeID,
-					Metric: &types.CellMetric{
-						PCI:   pci,
-						ARFCN: arfcn.GetNRarfcn(),
-					},
-					Neighbors:   cellInfo.GetNeighborRelationTable().GetNeighborCellList().GetValue(),
-					PCIPoolList: pciPoolList,
-				},
-			})
-			if err != nil {
-				return err
-			}
-
-			cellID, err := parse.GetCellID(cgi)
-			if err != nil {
-				return err
+			pci = nrt.GetServingCellPci().GetNR().GetValue()
+			arfcn = nrt.GetServingCellArfcn().GetNR().GetNRarfcn()
+		} else if cgi.GetEUtraCgi() != nil {
+			// 4G case
+			if nrt.GetServingCellPci().GetEUtra() == nil {
+				log.Errorf("PCI should be EUTRA PCI but EUTRA PCI field is empty in E2 Indication message")
+				continue
 			}
-			cellTopoID := topoapi.ID(fmt.Sprintf("%s/%s", nodeID, strconv.FormatUint(cellID, 16)))
-			err = m.rnibClient.UpdateCellAspects(ctx, cellTopoID, uint32(pci), cellInfo.GetNeighborRelationTable().GetNeighborCellList().GetValue(), uint32(arfcn.GetNRarfcn()))
-			if err != nil {
-				return err
+			if nrt.GetServingCellArfcn().GetEUtra() == nil {
+				log.Errorf("ARFCN should be EUTRA ARFCN but EUTRA ARFCN field is empty in E2 indication message")
+				continue
 			}
+			pci = nrt.GetServingCellPci().GetEUtra().GetValue()
+			arfcn = nrt.GetServingCellArfcn().GetEUtra().GetValue()
 		} else {
-			// 4G case
-			// ToDo: Add 4G case here
-			log.Errorf("4G case is not implemented yet")
+			log.Errorf("CGI should be NR CGI or EUTRA CGI: %v", cgi)
+			continue
+		}
+
+		key, err := metrics.ParseKey(cgi)
+		if err != nil {
+			log.Errorf("Skipping cell with invalid CGI %v: %v", cgi, err)
+			continue
+		}
+		pciPoolList := m.pciPools.GetPCIPools(ctx, nodeID, cgi, arfcn)
+		_, err = m.metricStore.Put(ctx, key, metrics.Entry{
+			Key: metrics.Key{
+				CellGlobalID: cgi,
+			},
+			Value: types.CellPCI{
+				E2NodeID: nodeID,
+				Cluster:  m.pciPools.GetCluster(nodeID),
+				Metric: &types.CellMetric{
+					PCI:   pci,
+					ARFCN: arfcn,
+				},
+				Neighbors:   nrt.GetNeighborCellList().GetValue(),
+				PCIPoolList: pciPoolList,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		cellTopoID, err := rnib.GetCellTopoID(nodeID, cgi)
+		if err != nil {
+			return err
+		}
+		err = m.rnibClient.UpdateCellAspects(ctx, cellTopoID, uint32(pci), nrt.GetNeighborCellList().GetValue(), uint32(arfcn))
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -136,26 +152,15 @@ func (m *Monitor) processIndication(ctx context.Context, indication e2api.Indica
 	return nil
 }
 
-// Start start monitoring of indication messages for a given subscription ID
+// Start start monitoring of indication messages for a given subscription ID;
+// it blocks until the stream is closed or the context is done
 func (m *Monitor) Start(ctx context.Context) error {
-	errCh := make(chan error)
-	go func() {
-		for {
-			indMsg, err := m.streamReader.Recv(ctx)
-			if err != nil {
-				errCh <- err
-			}
-			err = m.processIndication(ctx, indMsg, m.nodeID)
-			if err != nil {
-				errCh <- err
-			}
+	for {
+		indMsg, err := m.streamReader.Recv(ctx)
+		if err != nil {
+			return err
 		}
-	}()
-
-	select {
-	case err := <-errCh:
-		return err
-	case <-ctx.Done():
-		return ctx.Err()
+		// a malformed indication message should not stop monitoring the other messages; it is logged already
+		_ = m.processIndication(ctx, indMsg, m.nodeID)
 	}
 }
@@ -9,6 +9,7 @@ import (
 	topoapi "github.com/onosproject/onos-api/go/onos/topo"
 	"github.com/onosproject/onos-pci/pkg/broker"
 	appConfig "github.com/onosproject/onos-pci/pkg/config"
+	"github.com/onosproject/onos-pci/pkg/pools"
 	"github.com/onosproject/onos-pci/pkg/rnib"
 	"github.com/onosproject/onos-pci/pkg/store/metrics"
 
@@ -29,6 +30,8 @@ type AppOptions struct {
 	MetricStore metrics.Store
 
 	RNIBClient rnib.Client
+
+	PCIPools *pools.Provider
 }
 
 // MonitorOptions monitoring options
@@ -99,3 +102,10 @@ func WithRNIBClient(rnibClient rnib.Client) Option {
 		options.App.RNIBClient = rnibClient
 	})
 }
+
+// WithPCIPools sets PCI pool provider
+func WithPCIPools(pciPools *pools.Provider) Option {
+	return newOption(func(options *Options) {
+		options.App.PCIPools = pciPools
+	})
+}
@@ -7,104 +7,400 @@ package northbound
 
 import (
 	"context"
+	"time"
 
+	adminapi "github.com/onosproject/onos-pci/api"
+	"github.com/onosproject/onos-pci/pkg/controller"
 	"github.com/onosproject/onos-pci/pkg/utils/parse"
 
 	pciapi "github.com/onosproject/onos-api/go/onos/pci"
 	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
 	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 	service "github.com/onosproject/onos-lib-go/pkg/northbound"
+	"github.com/onosproject/onos-pci/pkg/store/history"
 	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/store/proposals"
+	"github.com/onosproject/onos-pci/pkg/store/reserved"
 
 	"github.com/onosproject/onos-pci/pkg/types"
 	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var log = logging.GetLogger()
 
+// ControlTimeout is how long SetPci waits for the outcome of the RC control message
+const ControlTimeout = 30 * time.Second
+
 // NewService returns a new PCI interface service.
-func NewService(store metrics.Store) service.Service {
+func NewService(store metrics.Store, reservedStore reserved.Store, pciCtrl *controller.PciController) service.Service {
 	return &Service{
-		store: store,
+		store:         store,
+		reservedStore: reservedStore,
+		pciCtrl:       pciCtrl,
 	}
 }
 
 // Service is a service implementation for administration.
 type Service struct {
-	store metrics.Store
+	store         metrics.Store
+	reservedStore reserved.Store
+	pciCtrl       *controller.PciController
 }
 
 // Register registers the Service with the gRPC server.
 func (s Service) Register(r *grpc.Server) {
 	server := &Server{
-		store: s.store,
+		store:         s.store,
+		reservedStore: s.reservedStore,
+		pciCtrl:       s.pciCtrl,
 	}
 	pciapi.RegisterPciServer(r, server)
+	adminapi.RegisterPciAdminServer(r, server)
 }
 
 // NewTestServer returns a server for testing purposes
 func NewTestServer(store metrics.Store) *Server {
-	return &Server{store: store}
+	reservedStore := reserved.NewStore()
+	pciCtrl := controller.NewPciController(store, controller.WithReservedStore(reservedStore))
+	return &Server{
+		store:         store,
+		reservedStore: reservedStore,
+		pciCtrl:       &pciCtrl,
+	}
 }
 
 type Server struct {
-	store metrics.Store
+	store         metrics.Store
+	reservedStore reserved.Store
+	pciCtrl       *controller.PciController
 }
 
-// GetConflicts returns how many conflicts occur for a given cell or cells in total
+// GetConflicts returns the cells involved in PCI collisions or confusions with a given cell or with any cell
 func (s *Server) GetConflicts(ctx context.Context, request *pciapi.GetConflictsRequest) (*pciapi.GetConflictsResponse, error) {
 	log.Infof("Received PCI Conflicts Request %v", request)
-	conflicts := make([]*pciapi.PciCell, 0)
-	if request.CellId != 0 {
-		cell, err := s.store.Get(ctx, request.CellId)
-		if err != nil {
-			return nil, err
-		}
-		pci := cell.Value.Metric.PCI
-		for i := range cell.Value.Neighbors {
-			neighbor := cell.Value.Neighbors[i]
-			if neighbor.GetRanTypeChoiceNr() != nil {
-				// 5G case
-				if pci == neighbor.GetRanTypeChoiceNr().GetNRPci().GetValue() {
-					temp, _ := s.store.Get(ctx, metrics.NewKey(&e2smrccomm.Cgi{
-						Cgi: &e2smrccomm.Cgi_NRCgi{
-							NRCgi: neighbor.GetRanTypeChoiceNr().GetNRCgi(),
-						},
-					}))
-					conflicts = append(conflicts, cellPciToPciCell(temp.Key, temp.Value))
-				}
-			} else if neighbor.GetRanTypeChoiceEutra() != nil {
-				// 4G case
-				// ToDo: Add 4G case
-				log.Errorf("4G case is not implemented yet")
+	conflicts, err := s.getConflicts(ctx, request.CellId)
+	if err != nil {
+		return nil, err
+	}
+
+	// each conflicting cell is reported once; for a given cell, only its conflicting peers are reported
+	cells := make([]*pciapi.PciCell, 0)
+	added := make(map[uint64]bool)
+	for _, c := range conflicts {
+		for _, id := range []uint64{c.CellID, c.PeerID} {
+			if added[id] || (request.CellId != 0 && (id == request.CellId || !c.Involves(request.CellId))) {
+				continue
 			}
+			cell, err := s.store.Get(ctx, id)
+			if err != nil {
+				log.Warnf("conflicting cell %v is not in the store: %v", id, err)
+				continue
+			}
+			added[id] = true
+			cells = append(cells, cellPciToPciCell(cell.Key, cell.Value))
+		}
+	}
+	return &pciapi.GetConflictsResponse{Cells: cells}, nil
+}
+
+// ListConflicts returns the PCI collisions and confusions with the pair of cells involved
+func (s *Server) ListConflicts(ctx context.Context, request *adminapi.ListConflictsRequest) (*adminapi.ListConflictsResponse, error) {
+	log.Infof("Received PCI List Conflicts Request %v", request)
+	conflicts, err := s.getConflicts(ctx, request.CellId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*adminapi.Conflict, 0, len(conflicts))
+	for _, c := range conflicts {
+		conflict := conflictToAPI(c)
+		if request.Type != adminapi.ConflictType_ANY_CONFLICT && request.Type != conflict.Type {
+			continue
+		}
+		out = append(out, conflict)
+	}
+	return &adminapi.ListConflictsResponse{Conflicts: out}, nil
+}
+
+// getConflicts returns the conflicts a given cell is involved in, or all conflicts if cell ID is 0
+func (s *Server) getConflicts(ctx context.Context, cellID uint64) ([]controller.Conflict, error) {
+	if cellID == 0 {
+		return s.pciCtrl.DetectAllConflicts(ctx)
+	}
+	cell, err := s.store.Get(ctx, cellID)
+	if err != nil {
+		return nil, err
+	}
+	return s.pciCtrl.DetectConflicts(ctx, cell), nil
+}
+
+// ListReservedPcis returns the reserved PCI lists of all scopes
+func (s *Server) ListReservedPcis(ctx context.Context, request *adminapi.ListReservedPcisRequest) (*adminapi.ListReservedPcisResponse, error) {
+	log.Infof("Received PCI List Reserved PCIs Request %v", request)
+	entries, err := s.reservedStore.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]*adminapi.ReservedPcis, 0, len(entries))
+	for _, entry := range entries {
+		out = append(out, reservedToAPI(entry))
+	}
+	return &adminapi.ListReservedPcisResponse{Reserved: out}, nil
+}
+
+// SetReservedPcis replaces the reserved PCI list of a scope
+func (s *Server) SetReservedPcis(ctx context.Context, request *adminapi.SetReservedPcisRequest) (*adminapi.SetReservedPcisResponse, error) {
+	log.Infof("Received PCI Set Reserved PCIs Request %v", request)
+	if request.Reserved == nil {
+		return nil, errors.Status(errors.NewInvalid("reserved PCIs should be set")).Err()
+	}
+	key := reserved.Key{
+		Scope:   reserved.Scope(request.Reserved.Scope),
+		PlmnID:  request.Reserved.PlmnId,
+		Cluster: request.Reserved.Cluster,
+	}
+	pcis := make([]int32, 0, len(request.Reserved.Pcis))
+	for _, pci := range request.Reserved.Pcis {
+		pcis = append(pcis, int32(pci))
+	}
+	if err := s.reservedStore.Set(ctx, key, pcis); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &adminapi.SetReservedPcisResponse{}, nil
+}
+
+// LockCell pins the current PCI of a cell
+func (s *Server) LockCell(ctx context.Context, request *adminapi.LockCellRequest) (*adminapi.LockCellResponse, error) {
+	log.Infof("Received PCI Lock Cell Request %v", request)
+	if err := s.store.SetLocked(ctx, request.CellId, true); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &adminapi.LockCellResponse{}, nil
+}
+
+// UnlockCell lets the PCI of a locked cell be changed again
+func (s *Server) UnlockCell(ctx context.Context, request *adminapi.UnlockCellRequest) (*adminapi.UnlockCellResponse, error) {
+	log.Infof("Received PCI Unlock Cell Request %v", request)
+	if err := s.store.SetLocked(ctx, request.CellId, false); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &adminapi.UnlockCellResponse{}, nil
+}
+
+// SetPci sets the PCI of a cell manually and waits for the outcome of the RC control message sent to the E2 node
+func (s *Server) SetPci(ctx context.Context, request *adminapi.SetPciRequest) (*adminapi.SetPciResponse, error) {
+	log.Infof("Received PCI Set PCI Request %v", request)
+	entry, err := s.store.Get(ctx, request.CellId)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	previousPci := entry.Value.Metric.PCI
+
+	// watch before the PCI is updated, so that the outcome is not missed; the watch is read only after the update,
+	// so it is disconnected rather than blocking the update if the outcomes of too many other changes are queued
+	ctx, cancel := context.WithTimeout(ctx, ControlTimeout)
+	defer cancel()
+	ch := make(chan metrics.Event)
+	if err := s.store.Watch(ctx, ch, metrics.WithEventTypes(metrics.ControlResult),
+		metrics.WithOverflowPolicy(metrics.Disconnect)); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	changeID, err := s.pciCtrl.SetPci(ctx, request.CellId, request.Pci)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+
+	// the outcome of an earlier change to the same PCI is not the outcome of this one
+	for e := range ch {
+		if e.Type != metrics.ControlResult || e.Key != request.CellId || e.Value.Value.LastControl == nil ||
+			e.Value.Value.LastControl.ChangeID != changeID {
+			continue
 		}
-	} else {
-		ch := make(chan *metrics.Entry, 1024)
-		err := s.store.Entries(ctx, ch)
+		result := e.Value.Value.LastControl
+		if result.Error != "" {
+			return nil, errors.Status(errors.NewUnavailable("RC control message for PCI %v of cell %v failed after %v attempts (%v %v), rolled back: %v: %v",
+				request.Pci, request.CellId, result.Attempts, result.Failure, result.Cause, result.RolledBack, result.Error)).Err()
+		}
+		return &adminapi.SetPciResponse{
+			CellId:      request.CellId,
+			Pci:         request.Pci,
+			PreviousPci: previousPci,
+			Outcome:     result.Outcome,
+		}, nil
+	}
+	if ctx.Err() == nil {
+		return nil, errors.Status(errors.NewUnavailable("outcome of RC control message for PCI %v of cell %v lost among too many outcomes",
+			request.Pci, request.CellId)).Err()
+	}
+	return nil, errors.Status(errors.NewTimeout("no outcome of RC control message for PCI %v of cell %v",
+		request.Pci, request.CellId)).Err()
+}
+
+// ListProposals lists the PCI changes proposed in dry-run mode
+func (s *Server) ListProposals(ctx context.Context, request *adminapi.ListProposalsRequest) (*adminapi.ListProposalsResponse, error) {
+	log.Debugf("Received PCI List Proposals Request %v", request)
+	list, err := s.pciCtrl.ListProposals(ctx)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	out := make([]*adminapi.Proposal, 0, len(list))
+	for _, proposal := range list {
+		out = append(out, proposalToAPI(proposal))
+	}
+	return &adminapi.ListProposalsResponse{
+		DryRun:    s.pciCtrl.DryRun(),
+		Proposals: out,
+	}, nil
+}
+
+// ApproveProposals applies the PCI changes proposed for the given cells, or all of them
+func (s *Server) ApproveProposals(ctx context.Context, request *adminapi.ApproveProposalsRequest) (*adminapi.ApproveProposalsResponse, error) {
+	log.Infof("Received PCI Approve Proposals Request %v", request)
+	cellIDs, err := s.getProposalCellIDs(ctx, request.CellIds, request.All)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+
+	response := &adminapi.ApproveProposalsResponse{
+		Approved: make([]*adminapi.Proposal, 0, len(cellIDs)),
+		Failed:   make([]*adminapi.DecisionFailure, 0),
+	}
+	for _, cellID := range cellIDs {
+		proposal, err := s.pciCtrl.ApproveProposal(ctx, cellID)
 		if err != nil {
-			return nil, err
+			log.Warnf("Could not approve PCI proposal for %v: %v", cellID, err)
+			response.Failed = append(response.Failed, &adminapi.DecisionFailure{
+				CellId: cellID,
+				Reason: err.Error(),
+			})
+			continue
 		}
-		// if a cell has a conflict, we only have to append the cell b/c conflicting neighbors will be appended individually
-		for cell := range ch {
-			pci := cell.Value.Metric.PCI
-			for i := range cell.Value.Neighbors {
-				if cell.Value.Neighbors[i].GetRanTypeChoiceNr() != nil {
-					// 5G case
-					if pci == cell.Value.Neighbors[i].GetRanTypeChoiceNr().GetNRPci().GetValue() {
-						conflicts = append(conflicts, cellPciToPciCell(cell.Key, cell.Value))
-						break
-					}
-				} else if cell.Value.Neighbors[i].GetRanTypeChoiceEutra() != nil {
-					// 4G case
-					// ToDo: Add 4G case
-					log.Errorf("4G case is not implemented yet")
-				}
-			}
+		response.Approved = append(response.Approved, proposalToAPI(proposal))
+	}
+	return response, nil
+}
+
+// RejectProposals rejects the PCI changes proposed for the given cells, or all of them
+func (s *Server) RejectProposals(ctx context.Context, request *adminapi.RejectProposalsRequest) (*adminapi.RejectProposalsResponse, error) {
+	log.Infof("Received PCI Reject Proposals Request %v", request)
+	cellIDs, err := s.getProposalCellIDs(ctx, request.CellIds, request.All)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+
+	response := &adminapi.RejectProposalsResponse{
+		Rejected: make([]*adminapi.Proposal, 0, len(cellIDs)),
+		Failed:   make([]*adminapi.DecisionFailure, 0),
+	}
+	for _, cellID := range cellIDs {
+		proposal, err := s.pciCtrl.RejectProposal(ctx, cellID, request.Reason)
+		if err != nil {
+			response.Failed = append(response.Failed, &adminapi.DecisionFailure{
+				CellId: cellID,
+				Reason: err.Error(),
+			})
+			continue
+		}
+		response.Rejected = append(response.Rejected, proposalToAPI(proposal))
+	}
+	return response, nil
+}
+
+// ListProposalHistory lists the decided PCI change proposals
+func (s *Server) ListProposalHistory(ctx context.Context, request *adminapi.ListProposalHistoryRequest) (*adminapi.ListProposalHistoryResponse, error) {
+	log.Debugf("Received PCI List Proposal History Request %v", request)
+	history, err := s.pciCtrl.ListProposalHistory(ctx)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	out := make([]*adminapi.Proposal, 0, len(history))
+	for _, proposal := range history {
+		out = append(out, proposalToAPI(proposal))
+	}
+	return &adminapi.ListProposalHistoryResponse{Proposals: out}, nil
+}
+
+// ListPciHistory lists the PCI changes of a cell, or of all cells, within a time range
+func (s *Server) ListPciHistory(ctx context.Context, request *adminapi.ListPciHistoryRequest) (*adminapi.ListPciHistoryResponse, error) {
+	log.Debugf("Received PCI List PCI History Request %v", request)
+	var start, end time.Time
+	if request.Start != nil {
+		start = request.Start.AsTime()
+	}
+	if request.End != nil {
+		end = request.End.AsTime()
+	}
+	records, err := s.pciCtrl.ListHistory(ctx, request.CellId, start, end)
+	if err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	changes := make([]*adminapi.PciChange, 0, len(records))
+	for _, record := range records {
+		changes = append(changes, historyToAPI(record))
+	}
+	return &adminapi.ListPciHistoryResponse{Changes: changes}, nil
+}
+
+// GetVerificationStats returns the numbers of PCI changes by verification against the next indication message
+func (s *Server) GetVerificationStats(_ context.Context, request *adminapi.GetVerificationStatsRequest) (*adminapi.GetVerificationStatsResponse, error) {
+	log.Infof("Received PCI Get Verification Stats Request %v", request)
+	stats := s.pciCtrl.GetVerificationStats()
+	return &adminapi.GetVerificationStatsResponse{
+		Pending:   uint32(stats.Pending),
+		Confirmed: stats.Confirmed,
+		Reverted:  stats.Reverted,
+		TimedOut:  stats.TimedOut,
+	}, nil
+}
+
+// ListFrozenCells lists the cells frozen after their PCI oscillated
+func (s *Server) ListFrozenCells(ctx context.Context, request *adminapi.ListFrozenCellsRequest) (*adminapi.ListFrozenCellsResponse, error) {
+	log.Infof("Received PCI List Frozen Cells Request %v", request)
+	alarms := s.pciCtrl.ListFrozenCells(ctx)
+	cells := make([]*adminapi.FrozenCell, 0, len(alarms))
+	for _, alarm := range alarms {
+		changes := make([]*timestamppb.Timestamp, 0, len(alarm.Changes))
+		for _, t := range alarm.Changes {
+			changes = append(changes, timestamppb.New(t))
 		}
+		cells = append(cells, &adminapi.FrozenCell{
+			CellId:   alarm.CellID,
+			FrozenAt: timestamppb.New(alarm.Time),
+			Changes:  changes,
+		})
 	}
-	return &pciapi.GetConflictsResponse{Cells: conflicts}, nil
+	return &adminapi.ListFrozenCellsResponse{Cells: cells}, nil
+}
+
+// UnfreezeCell lets the controller change the PCI of a frozen cell again
+func (s *Server) UnfreezeCell(ctx context.Context, request *adminapi.UnfreezeCellRequest) (*adminapi.UnfreezeCellResponse, error) {
+	log.Infof("Received PCI Unfreeze Cell Request %v", request)
+	if err := s.pciCtrl.UnfreezeCell(ctx, request.CellId); err != nil {
+		return nil, errors.Status(err).Err()
+	}
+	return &adminapi.UnfreezeCellResponse{}, nil
+}
+
+// getProposalCellIDs returns the given cells, or the cells of all pending proposals
+func (s *Server) getProposalCellIDs(ctx context.Context, cellIDs []uint64, all bool) ([]uint64, error) {
+	if !all {
+		if len(cellIDs) == 0 {
+			return nil, errors.NewInvalid("either cell IDs or all should be set")
+		}
+		return cellIDs, nil
+	}
+	list, err := s.pciCtrl.ListProposals(ctx)
+	if err != nil {
+		return nil, err
+	}
+	cellIDs = make([]uint64, 0, len(list))
+	for _, proposal := range list {
+		cellIDs = append(cellIDs, proposal.CellID)
+	}
+	return cellIDs, nil
 }
 
 func (s *Server) GetResolvedConflicts(ctx context.Context, _ *pciapi.GetResolvedConflictsRequest) (*pciapi.GetResolvedConflictsResponse, error) {
@@ -151,20 +447,81 @@ func (s *Server) GetCells(ctx context.Context, request *pciapi.GetCellsRequest)
 	return &pciapi.GetCellsResponse{Cells: output}, nil
 }
 
-// convert from NRCGI to uint64
+// convert from NRCGI or ECGI to uint64
 func cgiToInt(cgi *e2smrccomm.Cgi) uint64 {
-	if cgi.GetNRCgi() != nil {
-		// 5G case
-		array := cgi.GetNRCgi().GetPLmnidentity().GetValue()
-		plmnID := uint32(array[0])<<0 | uint32(array[1])<<8 | uint32(array[2])<<16
-		return uint64(plmnID)<<36 | parse.BitStringToUint64(cgi.GetNRCgi().GetNRcellIdentity().GetValue().GetValue(), int(cgi.GetNRCgi().GetNRcellIdentity().GetValue().GetLen()))
-	} else if cgi.GetEUtraCgi() != nil {
-		// 4G case
-		// ToDo: Add 4G case
-		log.Errorf("4G case is not implemented yet")
+	return metrics.NewKey(cgi)
+}
+
+// helper function to convert between controller conflicts and onos-pci admin API representation
+func conflictToAPI(c controller.Conflict) *adminapi.Conflict {
+	conflictType := adminapi.ConflictType_COLLISION
+	if c.Type == controller.Confusion {
+		conflictType = adminapi.ConflictType_CONFUSION
+	}
+	return &adminapi.Conflict{
+		Type:             conflictType,
+		CellId:           c.CellID,
+		PeerCellId:       c.PeerID,
+		CommonNeighborId: c.CommonNeighborID,
+		Pci:              uint32(c.PCI),
+		Arfcn:            uint32(c.ARFCN),
 	}
-	log.Errorf("CGI does not have EUTRA CGI or NR CGI")
-	return 0
+}
+
+// helper function to convert between reserved store and onos-pci admin API representation
+func reservedToAPI(entry *reserved.Entry) *adminapi.ReservedPcis {
+	pcis := make([]uint32, 0, len(entry.PCIs))
+	for _, pci := range entry.PCIs {
+		pcis = append(pcis, uint32(pci))
+	}
+	return &adminapi.ReservedPcis{
+		Scope:   adminapi.ReservationScope(entry.Key.Scope),
+		PlmnId:  entry.Key.PlmnID,
+		Cluster: entry.Key.Cluster,
+		Pcis:    pcis,
+	}
+}
+
+func proposalToAPI(proposal *proposals.Proposal) *adminapi.Proposal {
+	out := &adminapi.Proposal{
+		CellId:      proposal.CellID,
+		E2NodeId:    string(proposal.E2NodeID),
+		CurrentPci:  proposal.CurrentPCI,
+		ProposedPci: proposal.ProposedPCI,
+		CreatedAt:   timestamppb.New(proposal.CreatedAt),
+		NeighborIds: proposal.Neighbors,
+		Reason:      proposal.Reason,
+		Status:      adminapi.ProposalStatus(proposal.Status),
+	}
+	if proposal.Status != proposals.Pending {
+		out.DecidedAt = timestamppb.New(proposal.DecidedAt)
+		out.Decision = proposal.Decision
+	}
+	return out
+}
+
+func historyToAPI(record *history.Record) *adminapi.PciChange {
+	out := &adminapi.PciChange{
+		CellId:             record.CellID,
+		Time:               timestamppb.New(record.Time),
+		OldPci:             record.OldPCI,
+		NewPci:             record.NewPCI,
+		Trigger:            adminapi.PciChangeTrigger(record.Trigger),
+		Actor:              adminapi.PciChangeActor(record.Actor),
+		ConflictingCellIds: record.ConflictingCells,
+	}
+	if record.Outcome != nil {
+		out.ControlReceived = true
+		out.ControlError = record.Outcome.Error
+		out.ControlOutcome = record.Outcome.Outcome
+		out.ControlFailure = adminapi.ControlFailureType(record.Outcome.Failure)
+		out.ControlCause = record.Outcome.Cause
+		out.ControlAttempts = uint32(record.Outcome.Attempts)
+		out.RolledBack = record.Outcome.RolledBack
+	}
+	out.Verification = adminapi.PciChangeVerification(record.Verification)
+	out.ReportedPci = record.ReportedPCI
+	return out
 }
 
 // helper function used in cellPciToPciCell
@@ -182,28 +539,19 @@ func pciPoolToRange(list []*types.PCIPool) []*pciapi.PciRange {
 // helper function used in cellPciToPciCell
 func neighborsToIDs(list []*e2smrc.NeighborCellItem) []uint64 {
 	out := make([]uint64, 0)
-	for pool := range list {
-
-		if list[pool].GetRanTypeChoiceNr() != nil {
-			// 5G case
-			out = append(out, cgiToInt(&e2smrccomm.Cgi{
-				Cgi: &e2smrccomm.Cgi_NRCgi{
-					NRCgi: list[pool].GetRanTypeChoiceNr().GetNRCgi(),
-				},
-			}))
-		} else if list[pool].GetRanTypeChoiceEutra() != nil {
-			// 4G case
-			// ToDo: Add 4G case
-			log.Errorf("4G case is not implemented yet")
+	for i := range list {
+		neighborCGI, _, _, err := parse.GetNeighborInfo(list[i])
+		if err != nil {
+			log.Warn(err)
+			continue
 		}
+		out = append(out, cgiToInt(neighborCGI))
 	}
 	return out
 }
 
 // helper function to convert between onos-api representation and internal store
 func cellPciToPciCell(key metrics.Key, cell types.CellPCI) *pciapi.PciCell {
-	// 5G case
-	// 4G case
 	return &pciapi.PciCell{
 		Id:          cgiToInt(key.CellGlobalID),
 		NodeId:      string(cell.E2NodeID),
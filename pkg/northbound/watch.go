@@ -0,0 +1,327 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	adminapi "github.com/onosproject/onos-pci/api"
+	"github.com/onosproject/onos-pci/pkg/controller"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+)
+
+// watchedEvents are the store events changing the cells or their conflicts
+var watchedEvents = metrics.WithEventTypes(metrics.Created, metrics.Updated, metrics.UpdatedPCI, metrics.Deleted)
+
+// cellFilter matches the cells by ID, E2 node and ARFCN; an empty list matches all cells
+type cellFilter struct {
+	cellIDs   map[uint64]bool
+	e2NodeIDs map[topoapi.ID]bool
+	arfcns    map[uint32]bool
+}
+
+func newCellFilter(cellIDs []uint64, e2NodeIDs []string, arfcns []uint32) cellFilter {
+	f := cellFilter{}
+	if len(cellIDs) > 0 {
+		f.cellIDs = make(map[uint64]bool)
+		for _, id := range cellIDs {
+			f.cellIDs[id] = true
+		}
+	}
+	if len(e2NodeIDs) > 0 {
+		f.e2NodeIDs = make(map[topoapi.ID]bool)
+		for _, id := range e2NodeIDs {
+			f.e2NodeIDs[topoapi.ID(id)] = true
+		}
+	}
+	if len(arfcns) > 0 {
+		f.arfcns = make(map[uint32]bool)
+		for _, arfcn := range arfcns {
+			f.arfcns[arfcn] = true
+		}
+	}
+	return f
+}
+
+func (f cellFilter) matches(key uint64, cell types.CellPCI) bool {
+	return (f.cellIDs == nil || f.cellIDs[key]) &&
+		(f.e2NodeIDs == nil || f.e2NodeIDs[cell.E2NodeID]) &&
+		(f.arfcns == nil || f.arfcns[uint32(cell.Metric.ARFCN)])
+}
+
+// cellState is what a cell watch reports about a cell
+type cellState struct {
+	pci    int32
+	locked bool
+	stale  bool
+}
+
+// WatchCells streams the existing cells, then their additions, PCI changes, updates and removals
+func (s *Server) WatchCells(request *adminapi.WatchCellsRequest, server adminapi.PciAdmin_WatchCellsServer) error {
+	log.Infof("Received PCI Watch Cells Request %v", request)
+	ctx := server.Context()
+	filter := newCellFilter(request.CellIds, request.E2NodeIds, request.Arfcns)
+	ch := make(chan metrics.Event)
+	// a client too slow for the changes is disconnected rather than holding them back
+	if err := s.store.Watch(ctx, ch, metrics.WithReplay(), watchedEvents,
+		metrics.WithOverflowPolicy(metrics.Disconnect)); err != nil {
+		return errors.Status(err).Err()
+	}
+
+	// indication messages are sent periodically, so only the changes of the reported state are streamed
+	states := make(map[uint64]cellState)
+	for e := range ch {
+		cell := e.Value.Value
+		if !filter.matches(e.Key, cell) {
+			continue
+		}
+		state := cellState{pci: cell.Metric.PCI, locked: cell.Locked, stale: cell.Stale}
+		previous, known := states[e.Key]
+		response := &adminapi.WatchCellsResponse{
+			Cell: cellToAPI(e.Key, cell),
+		}
+		switch {
+		case e.Type == metrics.Deleted:
+			if !known {
+				continue
+			}
+			response.Type = adminapi.CellEventType_CELL_REMOVED
+			delete(states, e.Key)
+		case !known:
+			response.Type = adminapi.CellEventType_CELL_ADDED
+		case state.pci != previous.pci:
+			response.Type = adminapi.CellEventType_CELL_PCI_CHANGED
+			response.PreviousPci = uint32(previous.pci)
+		case state != previous:
+			response.Type = adminapi.CellEventType_CELL_UPDATED
+		default:
+			continue
+		}
+		if e.Type != metrics.Deleted {
+			states[e.Key] = state
+		}
+		if err := server.Send(response); err != nil {
+			return err
+		}
+	}
+	if ctx.Err() == nil {
+		return errors.Status(errors.NewUnavailable("cell watch is too slow for the changes")).Err()
+	}
+	return nil
+}
+
+// conflictState is what the conflicts of a cell depend on, so that the indication messages not changing it are skipped
+type conflictState struct {
+	pci       int32
+	arfcn     int32
+	stale     bool
+	neighbors string
+}
+
+func newConflictState(cell types.CellPCI) conflictState {
+	return conflictState{
+		pci:       cell.Metric.PCI,
+		arfcn:     cell.Metric.ARFCN,
+		stale:     cell.Stale,
+		neighbors: fmt.Sprint(neighborsToIDs(cell.Neighbors)),
+	}
+}
+
+// WatchConflicts streams the existing PCI conflicts, then the conflicts as they are detected and resolved
+func (s *Server) WatchConflicts(request *adminapi.WatchConflictsRequest, server adminapi.PciAdmin_WatchConflictsServer) error {
+	log.Infof("Received PCI Watch Conflicts Request %v", request)
+	ctx := server.Context()
+	filter := newCellFilter(request.CellIds, request.E2NodeIds, request.Arfcns)
+	// the store is watched first, so that no change after the detection of the existing conflicts is missed
+	ch := make(chan metrics.Event)
+	if err := s.store.Watch(ctx, ch, watchedEvents, metrics.WithOverflowPolicy(metrics.Disconnect)); err != nil {
+		return errors.Status(err).Err()
+	}
+	states, err := s.conflictStates(ctx)
+	if err != nil {
+		return errors.Status(err).Err()
+	}
+	existing, err := s.pciCtrl.DetectAllConflicts(ctx)
+	if err != nil && !errors.IsNotFound(err) {
+		return errors.Status(err).Err()
+	}
+
+	// conflicts are all current conflicts; the filtered ones are sent, and they are the only ones resolved later
+	conflicts := make(map[controller.Conflict]bool)
+	detect := func(c controller.Conflict) error {
+		sent := s.matchesConflict(ctx, filter, request.Type, c)
+		conflicts[c.ID()] = sent
+		if !sent {
+			return nil
+		}
+		return server.Send(&adminapi.WatchConflictsResponse{
+			Type:     adminapi.ConflictEventType_CONFLICT_DETECTED,
+			Conflict: conflictToAPI(c),
+		})
+	}
+	for _, c := range existing {
+		if err := detect(c); err != nil {
+			return err
+		}
+	}
+
+	// the conflicts are only detected again for the cells whose conflict state changed, once per cell for
+	// the events read at once, so that the periodic indication messages do not slow the watch down
+	for e := range ch {
+		changed := make(map[uint64]bool)
+		for ok := true; ok; e, ok = nextEvent(ch) {
+			if e.Type == metrics.Deleted {
+				delete(states, e.Key)
+				changed[e.Key] = true
+				continue
+			}
+			state := newConflictState(e.Value.Value)
+			if previous, known := states[e.Key]; !known || state != previous {
+				states[e.Key] = state
+				changed[e.Key] = true
+			}
+		}
+		for key := range changed {
+			if err := s.updateConflicts(ctx, server, key, conflicts, detect); err != nil {
+				return err
+			}
+		}
+	}
+	if ctx.Err() == nil {
+		return errors.Status(errors.NewUnavailable("conflict watch is too slow for the changes")).Err()
+	}
+	return nil
+}
+
+// nextEvent reads the next event if one is already waiting
+func nextEvent(ch chan metrics.Event) (metrics.Event, bool) {
+	select {
+	case e, ok := <-ch:
+		return e, ok
+	default:
+		return metrics.Event{}, false
+	}
+}
+
+// conflictStates gets the conflict states of the cells in store
+func (s *Server) conflictStates(ctx context.Context) (map[uint64]conflictState, error) {
+	ch := make(chan *metrics.Entry, 1024)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.store.Entries(ctx, ch)
+	}()
+	states := make(map[uint64]conflictState)
+	for entry := range ch {
+		states[metrics.NewKey(entry.Key.CellGlobalID)] = newConflictState(entry.Value)
+	}
+	if err := <-errCh; err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	return states, nil
+}
+
+// updateConflicts resolves the conflicts a changed cell was involved in or the common neighbor of and which are
+// gone, and detects its new conflicts
+func (s *Server) updateConflicts(ctx context.Context, server adminapi.PciAdmin_WatchConflictsServer, key uint64,
+	conflicts map[controller.Conflict]bool, detect func(controller.Conflict) error) error {
+	detected := s.detectConflicts(ctx, key)
+	for id, sent := range conflicts {
+		if !id.Involves(key) && id.CommonNeighborID != key {
+			continue
+		}
+		if _, ok := detected[id]; ok {
+			continue
+		}
+		delete(conflicts, id)
+		if !sent {
+			continue
+		}
+		if err := server.Send(&adminapi.WatchConflictsResponse{
+			Type:     adminapi.ConflictEventType_CONFLICT_RESOLVED,
+			Conflict: conflictToAPI(id),
+		}); err != nil {
+			return err
+		}
+	}
+	for id, c := range detected {
+		if _, ok := conflicts[id]; ok {
+			continue
+		}
+		if err := detect(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// detectConflicts returns the conflicts a cell is involved in or the common neighbor of, including the collisions
+// found only by the cells listing it, so that a conflict detected from either side is not taken as resolved
+func (s *Server) detectConflicts(ctx context.Context, key uint64) map[controller.Conflict]controller.Conflict {
+	detected := make(map[controller.Conflict]controller.Conflict)
+	entry, err := s.store.Get(ctx, key)
+	if err != nil {
+		return detected
+	}
+	for _, c := range s.pciCtrl.DetectConflicts(ctx, entry) {
+		detected[c.ID()] = c
+	}
+	neighborKeys, err := s.store.GetNeighbors(ctx, key)
+	if err != nil {
+		return detected
+	}
+	for _, k := range neighborKeys {
+		n, err := s.store.Get(ctx, k)
+		if err != nil {
+			continue
+		}
+		for _, c := range s.pciCtrl.DetectConflicts(ctx, n) {
+			if c.Type == controller.Collision && c.Involves(key) {
+				detected[c.ID()] = c
+			}
+		}
+	}
+	return detected
+}
+
+// matchesConflict checks if a conflict has the requested type and ARFCN, and involves a cell matching the filter
+func (s *Server) matchesConflict(ctx context.Context, filter cellFilter, conflictType adminapi.ConflictType, c controller.Conflict) bool {
+	if conflictType != adminapi.ConflictType_ANY_CONFLICT && conflictType != conflictToAPI(c).Type {
+		return false
+	}
+	if filter.arfcns != nil && !filter.arfcns[uint32(c.ARFCN)] {
+		return false
+	}
+	for _, id := range []uint64{c.CellID, c.PeerID} {
+		if filter.cellIDs != nil && !filter.cellIDs[id] {
+			continue
+		}
+		if filter.e2NodeIDs != nil {
+			entry, err := s.store.Get(ctx, id)
+			if err != nil || !filter.e2NodeIDs[entry.Value.E2NodeID] {
+				continue
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// helper function to convert between internal store and onos-pci admin API representation
+func cellToAPI(key uint64, cell types.CellPCI) *adminapi.Cell {
+	return &adminapi.Cell{
+		Id:          key,
+		E2NodeId:    string(cell.E2NodeID),
+		Arfcn:       uint32(cell.Metric.ARFCN),
+		Pci:         uint32(cell.Metric.PCI),
+		NeighborIds: neighborsToIDs(cell.Neighbors),
+		Locked:      cell.Locked,
+		Stale:       cell.Stale,
+	}
+}
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
+	"github.com/onosproject/onos-lib-go/api/asn1/v1/asn1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	adminapi "github.com/onosproject/onos-pci/api"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func testNRCgi(nci byte) *e2smrccomm.NrCgi {
+	return &e2smrccomm.NrCgi{
+		PLmnidentity: &e2smrccomm.Plmnidentity{Value: []byte{38, 132, 19}},
+		NRcellIdentity: &e2smrccomm.NrcellIdentity{
+			Value: &asn1.BitString{Value: []byte{0x00, 0x00, 0x00, 0x00, nci << 4}, Len: 36},
+		},
+	}
+}
+
+func testKey(nci byte) uint64 {
+	return metrics.NewKey(&e2smrccomm.Cgi{Cgi: &e2smrccomm.Cgi_NRCgi{NRCgi: testNRCgi(nci)}})
+}
+
+// putTestCell puts an NR cell on ARFCN 100 with its neighbor list in a store
+func putTestCell(t *testing.T, store metrics.Store, nci byte, pci int32, e2NodeID topoapi.ID, neighbors ...byte) {
+	items := make([]*e2smrc.NeighborCellItem, 0)
+	for _, n := range neighbors {
+		items = append(items, &e2smrc.NeighborCellItem{
+			NeighborCellItem: &e2smrc.NeighborCellItem_RanTypeChoiceNr{
+				RanTypeChoiceNr: &e2smrc.NeighborCellItemChoiceNr{
+					NRCgi: testNRCgi(n),
+					NRPci: &e2smrccomm.NrPci{},
+					NRFreqInfo: &e2smrccomm.NrfrequencyInfo{
+						NrArfcn: &e2smrccomm.NrArfcn{NRarfcn: 100},
+					},
+				},
+			},
+		})
+	}
+	_, err := store.Put(context.Background(), testKey(nci), metrics.Entry{
+		Key: metrics.Key{CellGlobalID: &e2smrccomm.Cgi{Cgi: &e2smrccomm.Cgi_NRCgi{NRCgi: testNRCgi(nci)}}},
+		Value: types.CellPCI{
+			E2NodeID:  e2NodeID,
+			Metric:    &types.CellMetric{ARFCN: 100, PCI: pci},
+			Neighbors: items,
+		},
+	})
+	assert.NoError(t, err)
+}
+
+// testWatchServer is a watch stream whose responses are read from a channel; release holds its first response back
+type testWatchServer[T any] struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan T
+	release   chan struct{}
+}
+
+func newTestWatchServer[T any](ctx context.Context) *testWatchServer[T] {
+	release := make(chan struct{})
+	close(release)
+	return &testWatchServer[T]{
+		ctx:       ctx,
+		responses: make(chan T, 100),
+		release:   release,
+	}
+}
+
+func (s *testWatchServer[T]) Send(response T) error {
+	<-s.release
+	s.responses <- response
+	return nil
+}
+
+func (s *testWatchServer[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testWatchServer[T]) next(t *testing.T) T {
+	select {
+	case response := <-s.responses:
+		return response
+	case <-time.After(time.Second):
+		assert.Fail(t, "no response")
+		var none T
+		return none
+	}
+}
+
+func (s *testWatchServer[T]) assertNone(t *testing.T) {
+	select {
+	case response := <-s.responses:
+		assert.Fail(t, "unexpected response", "%v", response)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestWatchCells(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	putTestCell(t, store, 1, 1, "e2:1")
+	putTestCell(t, store, 2, 2, "e2:2")
+	server := NewTestServer(store)
+	stream := newTestWatchServer[*adminapi.WatchCellsResponse](ctx)
+	go func() {
+		_ = server.WatchCells(&adminapi.WatchCellsRequest{E2NodeIds: []string{"e2:1"}}, stream)
+	}()
+	assert.Eventually(t, func() bool {
+		return len(store.WatcherStats(ctx)) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// only the cells of the filtered E2 node are streamed
+	response := stream.next(t)
+	assert.Equal(t, adminapi.CellEventType_CELL_ADDED, response.Type)
+	assert.Equal(t, testKey(1), response.Cell.Id)
+	stream.assertNone(t)
+
+	// an indication message without changes is not streamed
+	putTestCell(t, store, 1, 1, "e2:1")
+	putTestCell(t, store, 3, 3, "e2:2")
+	stream.assertNone(t)
+
+	assert.NoError(t, store.UpdatePci(ctx, testKey(1), 4, 0))
+	response = stream.next(t)
+	assert.Equal(t, adminapi.CellEventType_CELL_PCI_CHANGED, response.Type)
+	assert.Equal(t, uint32(4), response.Cell.Pci)
+	assert.Equal(t, uint32(1), response.PreviousPci)
+
+	assert.NoError(t, store.SetLocked(ctx, testKey(1), true))
+	response = stream.next(t)
+	assert.Equal(t, adminapi.CellEventType_CELL_UPDATED, response.Type)
+	assert.True(t, response.Cell.Locked)
+
+	assert.NoError(t, store.Delete(ctx, testKey(2)))
+	assert.NoError(t, store.Delete(ctx, testKey(1)))
+	response = stream.next(t)
+	assert.Equal(t, adminapi.CellEventType_CELL_REMOVED, response.Type)
+	assert.Equal(t, testKey(1), response.Cell.Id)
+	stream.assertNone(t)
+}
+
+func TestWatchCellsDisconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	putTestCell(t, store, 1, 1, "e2:1")
+	server := NewTestServer(store)
+	stream := newTestWatchServer[*adminapi.WatchCellsResponse](ctx)
+	stream.release = make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.WatchCells(&adminapi.WatchCellsRequest{}, stream)
+	}()
+
+	// a client not reading the changes is disconnected once its queue is full
+	assert.Eventually(t, func() bool {
+		return len(store.WatcherStats(ctx)) == 1
+	}, time.Second, 10*time.Millisecond)
+	for i := 0; i <= 2*metrics.DefaultQueueSize; i++ {
+		assert.NoError(t, store.SetLocked(ctx, testKey(1), i%2 == 0))
+	}
+	close(stream.release)
+	select {
+	case err := <-errCh:
+		assert.True(t, errors.IsUnavailable(errors.FromGRPC(err)))
+	case <-time.After(time.Second):
+		assert.Fail(t, "client not disconnected")
+	}
+}
+
+func TestWatchConflicts(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	store := metrics.NewStore()
+	// only cell 1 lists cell 2, and they collide
+	putTestCell(t, store, 1, 1, "e2:1", 2)
+	putTestCell(t, store, 2, 1, "e2:2")
+	putTestCell(t, store, 3, 3, "e2:2")
+	server := NewTestServer(store)
+	stream := newTestWatchServer[*adminapi.WatchConflictsResponse](ctx)
+	go func() {
+		_ = server.WatchConflicts(&adminapi.WatchConflictsRequest{CellIds: []uint64{testKey(2)}}, stream)
+	}()
+	confusions := newTestWatchServer[*adminapi.WatchConflictsResponse](ctx)
+	go func() {
+		_ = server.WatchConflicts(&adminapi.WatchConflictsRequest{Type: adminapi.ConflictType_CONFUSION}, confusions)
+	}()
+	assert.Eventually(t, func() bool {
+		return len(store.WatcherStats(ctx)) == 2
+	}, time.Second, 10*time.Millisecond)
+
+	response := stream.next(t)
+	assert.Equal(t, adminapi.ConflictEventType_CONFLICT_DETECTED, response.Type)
+	assert.Equal(t, adminapi.ConflictType_COLLISION, response.Conflict.Type)
+	assert.ElementsMatch(t, []uint64{testKey(1), testKey(2)}, []uint64{response.Conflict.CellId, response.Conflict.PeerCellId})
+
+	// a change of the cell not listing the other one does not resolve the collision
+	putTestCell(t, store, 2, 1, "e2:2", 3)
+	stream.assertNone(t)
+
+	assert.NoError(t, store.UpdatePci(ctx, testKey(2), 2, 0))
+	response = stream.next(t)
+	assert.Equal(t, adminapi.ConflictEventType_CONFLICT_RESOLVED, response.Type)
+	assert.NoError(t, store.UpdatePci(ctx, testKey(2), 1, 0))
+	response = stream.next(t)
+	assert.Equal(t, adminapi.ConflictEventType_CONFLICT_DETECTED, response.Type)
+
+	// the conflicts of the other cells are filtered out
+	putTestCell(t, store, 4, 4, "e2:1", 3)
+	putTestCell(t, store, 3, 4, "e2:2")
+	stream.assertNone(t)
+	confusions.assertNone(t)
+
+	// the confusion of cells 2 and 3 as neighbors of cell 1 is only sent to the confusion watch, and the collision
+	// of cell 2 with cell 3 it lists is detected along with it, in any order
+	putTestCell(t, store, 1, 1, "e2:1", 2, 3)
+	assert.NoError(t, store.UpdatePci(ctx, testKey(3), 1, 0))
+	response = confusions.next(t)
+	assert.Equal(t, adminapi.ConflictEventType_CONFLICT_DETECTED, response.Type)
+	assert.Equal(t, adminapi.ConflictType_CONFUSION, response.Conflict.Type)
+	assert.Equal(t, testKey(1), response.Conflict.CommonNeighborId)
+	conflictTypes := make([]adminapi.ConflictType, 0, 2)
+	for i := 0; i < 2; i++ {
+		response = stream.next(t)
+		assert.Equal(t, adminapi.ConflictEventType_CONFLICT_DETECTED, response.Type)
+		conflictTypes = append(conflictTypes, response.Conflict.Type)
+	}
+	assert.ElementsMatch(t, []adminapi.ConflictType{adminapi.ConflictType_COLLISION, adminapi.ConflictType_CONFUSION}, conflictTypes)
+	stream.assertNone(t)
+	confusions.assertNone(t)
+
+	// removing the common neighbor resolves the confusion
+	assert.NoError(t, store.Delete(ctx, testKey(1)))
+	response = confusions.next(t)
+	assert.Equal(t, adminapi.ConflictEventType_CONFLICT_RESOLVED, response.Type)
+}
@@ -0,0 +1,264 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pools
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-lib-go/pkg/logging"
+	appConfig "github.com/onosproject/onos-pci/pkg/config"
+	"github.com/onosproject/onos-pci/pkg/rnib"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/onosproject/onos-pci/pkg/utils"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+	"github.com/onosproject/onos-ric-sdk-go/pkg/config/event"
+)
+
+var log = logging.GetLogger()
+
+// NewProvider creates a new PCI pool provider
+func NewProvider(appConfig *appConfig.AppConfig, rnibClient rnib.Client) *Provider {
+	provider := &Provider{
+		appConfig:  appConfig,
+		rnibClient: rnibClient,
+	}
+	if err := provider.Load(); err != nil {
+		log.Infof("Using default PCI pools: %v", err)
+	}
+	return provider
+}
+
+// Provider provides the geographic cluster and the PCI pool of each cell; the PCI pool is, by order of precedence: the pool configured for the cell,
+// the pool set in the R-NIB E2 cell aspect, the pool configured for the E2 node, the pool configured for the ARFCN,
+// the default pool configured for the RAT, and the whole PCI range of the RAT
+type Provider struct {
+	appConfig  *appConfig.AppConfig
+	rnibClient rnib.Client
+	config     types.PCIPoolConfig
+	clusters   map[topoapi.ID]string
+	mu         sync.RWMutex
+}
+
+// Load loads the PCI pool and cluster configuration from the app config; invalid pools are dropped
+func (p *Provider) Load() error {
+	if p.appConfig == nil {
+		return errors.NewNotFound("app config does not exist")
+	}
+	clusters, err := p.appConfig.GetClusters(utils.ClustersConfigPath)
+	if err != nil {
+		log.Infof("No geographic clusters: %v", err)
+		clusters = make(map[topoapi.ID]string)
+	}
+	p.mu.Lock()
+	p.clusters = clusters
+	p.mu.Unlock()
+
+	config, err := p.appConfig.GetPCIPoolConfig(utils.PCIPoolsConfigPath)
+	if err != nil {
+		return err
+	}
+
+	if config.NR != nil {
+		if err := ValidatePCIPools(config.NR, types.LowerNRPCI, types.UpperNRPCI); err != nil {
+			log.Warnf("Dropping default NR PCI pool: %v", err)
+			config.NR = nil
+		}
+	}
+	if config.EUTRA != nil {
+		if err := ValidatePCIPools(config.EUTRA, types.LowerEUTRAPCI, types.UpperEUTRAPCI); err != nil {
+			log.Warnf("Dropping default EUTRA PCI pool: %v", err)
+			config.EUTRA = nil
+		}
+	}
+	// the RAT of the cells is not known yet, so these are checked against the RAT bounds when they are resolved
+	for nodeID, pools := range config.Nodes {
+		if err := ValidatePCIPools(pools, types.LowerNRPCI, types.UpperNRPCI); err != nil {
+			log.Warnf("Dropping PCI pool of E2 node %v: %v", nodeID, err)
+			delete(config.Nodes, nodeID)
+		}
+	}
+	for cellID, pools := range config.Cells {
+		if err := ValidatePCIPools(pools, types.LowerNRPCI, types.UpperNRPCI); err != nil {
+			log.Warnf("Dropping PCI pool of cell %v: %v", cellID, err)
+			delete(config.Cells, cellID)
+		}
+	}
+	for arfcn, pools := range config.ARFCNs {
+		if err := ValidatePCIPools(pools, types.LowerNRPCI, types.UpperNRPCI); err != nil {
+			log.Warnf("Dropping PCI pool of ARFCN %v: %v", arfcn, err)
+			delete(config.ARFCNs, arfcn)
+		}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config = config
+	return nil
+}
+
+// GetCluster returns the geographic cluster of the cells of an E2 node; empty if the E2 node is in no cluster
+func (p *Provider) GetCluster(nodeID topoapi.ID) string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.clusters[nodeID]
+}
+
+// GetPCIPools returns the PCI pool of a cell
+func (p *Provider) GetPCIPools(ctx context.Context, nodeID topoapi.ID, cgi *e2smrccomm.Cgi, arfcn int32) []*types.PCIPool {
+	lower, upper := int32(types.LowerNRPCI), int32(types.UpperNRPCI)
+	if parse.GetCGIType(cgi) == parse.CGITypeECGI {
+		lower, upper = types.LowerEUTRAPCI, types.UpperEUTRAPCI
+	}
+
+	p.mu.RLock()
+	config := p.config
+	p.mu.RUnlock()
+
+	candidates := []struct {
+		source string
+		pools  func() []*types.PCIPool
+	}{
+		{"cell", func() []*types.PCIPool { return config.Cells[metrics.NewKey(cgi)] }},
+		{"R-NIB", func() []*types.PCIPool { return p.getRNIBPCIPools(ctx, config, nodeID, cgi) }},
+		{"E2 node", func() []*types.PCIPool { return config.Nodes[nodeID] }},
+		{"ARFCN", func() []*types.PCIPool { return config.ARFCNs[arfcn] }},
+		{"default", func() []*types.PCIPool {
+			if parse.GetCGIType(cgi) == parse.CGITypeECGI {
+				return config.EUTRA
+			}
+			return config.NR
+		}},
+	}
+	for _, candidate := range candidates {
+		pools := candidate.pools()
+		if pools == nil {
+			continue
+		}
+		if err := ValidatePCIPools(pools, lower, upper); err != nil {
+			log.Warnf("Skipping %s PCI pool of %v: %v", candidate.source, metrics.NewKey(cgi), err)
+			continue
+		}
+		return pools
+	}
+	return []*types.PCIPool{
+		{
+			LowerPci: lower,
+			UpperPci: upper,
+		},
+	}
+}
+
+func (p *Provider) getRNIBPCIPools(ctx context.Context, config types.PCIPoolConfig, nodeID topoapi.ID, cgi *e2smrccomm.Cgi) []*types.PCIPool {
+	if !config.RNIB {
+		return nil
+	}
+	cellTopoID, err := rnib.GetCellTopoID(nodeID, cgi)
+	if err != nil {
+		log.Warn(err)
+		return nil
+	}
+	pools, err := p.rnibClient.GetCellPCIPools(ctx, cellTopoID)
+	if err != nil {
+		log.Debugf("No PCI pool in R-NIB for %v: %v", cellTopoID, err)
+		return nil
+	}
+	return pools
+}
+
+// Watch reloads the PCI pool and cluster configuration whenever the app config changes
+// and updates the PCI pools and clusters of the cells in store accordingly
+func (p *Provider) Watch(ctx context.Context, store metrics.Store) error {
+	if p.appConfig == nil {
+		return nil
+	}
+	ch := make(chan event.Event)
+	err := p.appConfig.Watch(ctx, ch)
+	if err != nil {
+		return err
+	}
+	go func() {
+		for e := range ch {
+			log.Debugf("App config changed: %v", e)
+			if err := p.Load(); err != nil {
+				log.Warnf("Failed to reload PCI pools: %v", err)
+				continue
+			}
+			p.updateStore(ctx, store)
+		}
+	}()
+	return nil
+}
+
+func (p *Provider) updateStore(ctx context.Context, store metrics.Store) {
+	ch := make(chan *metrics.Entry, 1024)
+	go func() {
+		err := store.Entries(ctx, ch)
+		if err != nil {
+			log.Error(err)
+		}
+	}()
+	entries := make([]*metrics.Entry, 0)
+	for entry := range ch {
+		entries = append(entries, entry)
+	}
+
+	// only the pools and the cluster are set, so that the changes made to the entries meanwhile are kept
+	for _, entry := range entries {
+		pools := p.GetPCIPools(ctx, entry.Value.E2NodeID, entry.Key.CellGlobalID, entry.Value.Metric.ARFCN)
+		cluster := p.GetCluster(entry.Value.E2NodeID)
+		if equalPCIPools(entry.Value.PCIPoolList, pools) && entry.Value.Cluster == cluster {
+			continue
+		}
+		log.Infof("PCI pool or cluster of %v changed to %v, %v", entry.Key, pools, cluster)
+		if err := store.SetPCIPools(ctx, metrics.NewKey(entry.Key.CellGlobalID), pools, cluster); err != nil {
+			log.Warn(err)
+		}
+	}
+}
+
+// ValidatePCIPools checks that the PCI ranges of a pool are not inverted, do not overlap each other
+// and are within the PCI range of the RAT
+func ValidatePCIPools(pools []*types.PCIPool, lower int32, upper int32) error {
+	if len(pools) == 0 {
+		return errors.NewInvalid("PCI pool should have at least one PCI range")
+	}
+	sorted := make([]*types.PCIPool, len(pools))
+	copy(sorted, pools)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LowerPci < sorted[j].LowerPci
+	})
+	for i, pool := range sorted {
+		if pool.LowerPci > pool.UpperPci {
+			return errors.NewInvalid("PCI range %v..%v is inverted", pool.LowerPci, pool.UpperPci)
+		}
+		if pool.LowerPci < lower || pool.UpperPci > upper {
+			return errors.NewInvalid("PCI range %v..%v is out of %v..%v", pool.LowerPci, pool.UpperPci, lower, upper)
+		}
+		if i > 0 && pool.LowerPci <= sorted[i-1].UpperPci {
+			return errors.NewInvalid("PCI ranges %v..%v and %v..%v overlap",
+				sorted[i-1].LowerPci, sorted[i-1].UpperPci, pool.LowerPci, pool.UpperPci)
+		}
+	}
+	return nil
+}
+
+func equalPCIPools(a []*types.PCIPool, b []*types.PCIPool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if *a[i] != *b[i] {
+			return false
+		}
+	}
+	return true
+}
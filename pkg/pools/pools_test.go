@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pools
+
+import (
+	"context"
+	"testing"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	"github.com/onosproject/onos-lib-go/api/asn1/v1/asn1"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	nrCGI = &e2smrccomm.Cgi{
+		Cgi: &e2smrccomm.Cgi_NRCgi{
+			NRCgi: &e2smrccomm.NrCgi{
+				PLmnidentity: &e2smrccomm.Plmnidentity{Value: []byte{38, 132, 19}},
+				NRcellIdentity: &e2smrccomm.NrcellIdentity{
+					Value: &asn1.BitString{Value: []byte{0x00, 0x00, 0x00, 0x10, 0x10}, Len: 36},
+				},
+			},
+		},
+	}
+	eutraCGI = &e2smrccomm.Cgi{
+		Cgi: &e2smrccomm.Cgi_EUtraCgi{
+			EUtraCgi: &e2smrccomm.EutraCgi{
+				PLmnidentity: &e2smrccomm.Plmnidentity{Value: []byte{38, 132, 19}},
+				EUtracellIdentity: &e2smrccomm.EutracellIdentity{
+					Value: &asn1.BitString{Value: []byte{0x00, 0x00, 0x10, 0x10}, Len: 28},
+				},
+			},
+		},
+	}
+)
+
+func pciRange(lower int32, upper int32) []*types.PCIPool {
+	return []*types.PCIPool{{LowerPci: lower, UpperPci: upper}}
+}
+
+func TestValidatePCIPools(t *testing.T) {
+	assert.NoError(t, ValidatePCIPools(pciRange(0, 1007), types.LowerNRPCI, types.UpperNRPCI))
+	assert.NoError(t, ValidatePCIPools([]*types.PCIPool{
+		{LowerPci: 20, UpperPci: 30},
+		{LowerPci: 1, UpperPci: 10},
+	}, types.LowerNRPCI, types.UpperNRPCI))
+
+	assert.Error(t, ValidatePCIPools(nil, types.LowerNRPCI, types.UpperNRPCI))
+	assert.Error(t, ValidatePCIPools(pciRange(10, 1), types.LowerNRPCI, types.UpperNRPCI))
+	assert.Error(t, ValidatePCIPools(pciRange(0, 1007), types.LowerEUTRAPCI, types.UpperEUTRAPCI))
+	assert.Error(t, ValidatePCIPools([]*types.PCIPool{
+		{LowerPci: 1, UpperPci: 10},
+		{LowerPci: 10, UpperPci: 20},
+	}, types.LowerNRPCI, types.UpperNRPCI))
+}
+
+func TestGetPCIPools(t *testing.T) {
+	ctx := context.Background()
+	provider := &Provider{}
+	assert.Equal(t, pciRange(0, 1007), provider.GetPCIPools(ctx, "e2:1", nrCGI, 100))
+	assert.Equal(t, pciRange(0, 503), provider.GetPCIPools(ctx, "e2:1", eutraCGI, 100))
+
+	provider.config = types.PCIPoolConfig{
+		NR:     pciRange(1, 500),
+		EUTRA:  pciRange(0, 300),
+		Nodes:  map[topoapi.ID][]*types.PCIPool{"e2:1": pciRange(1, 100)},
+		Cells:  map[uint64][]*types.PCIPool{metrics.NewKey(nrCGI): pciRange(1, 10)},
+		ARFCNs: map[int32][]*types.PCIPool{100: pciRange(1, 200), 200: pciRange(600, 700)},
+	}
+	assert.Equal(t, pciRange(1, 10), provider.GetPCIPools(ctx, "e2:1", nrCGI, 100))
+	assert.Equal(t, pciRange(1, 100), provider.GetPCIPools(ctx, "e2:1", eutraCGI, 100))
+	assert.Equal(t, pciRange(1, 200), provider.GetPCIPools(ctx, "e2:2", eutraCGI, 100))
+	assert.Equal(t, pciRange(0, 300), provider.GetPCIPools(ctx, "e2:2", eutraCGI, 300))
+	// the pool of the ARFCN is out of the EUTRA PCI range
+	assert.Equal(t, pciRange(0, 300), provider.GetPCIPools(ctx, "e2:2", eutraCGI, 200))
+}
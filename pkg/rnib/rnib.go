@@ -7,11 +7,17 @@ package rnib
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 	"github.com/onosproject/onos-lib-go/pkg/logging"
 
 	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
 	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
+	"github.com/onosproject/onos-pci/pkg/types"
 	"github.com/onosproject/onos-pci/pkg/utils/decode"
 	"github.com/onosproject/onos-pci/pkg/utils/parse"
 	toposdk "github.com/onosproject/onos-ric-sdk-go/pkg/topo"
@@ -19,6 +25,17 @@ import (
 
 var log = logging.GetLogger()
 
+// PCIPoolsAspectType is the type of the E2 cell aspect with the PCI pools of the cell,
+// e.g., {"pools": [{"lower": 1, "upper": 100}]}
+const PCIPoolsAspectType = "onos.pci.PciPools"
+
+type pciPoolsAspect struct {
+	Pools []struct {
+		Lower int32 `json:"lower"`
+		Upper int32 `json:"upper"`
+	} `json:"pools"`
+}
+
 // TopoClient R-NIB client interface
 type TopoClient interface {
 	WatchE2Connections(ctx context.Context, ch chan topoapi.Event) error
@@ -26,6 +43,7 @@ type TopoClient interface {
 	GetCells(ctx context.Context, nodeID topoapi.ID) ([]*topoapi.E2Cell, error)
 	E2NodeIDs(ctx context.Context, oid string) ([]topoapi.ID, error)
 	HasRCRANFunction(ctx context.Context, nodeID topoapi.ID, oid string) bool
+	GetCellPCIPools(ctx context.Context, cellID topoapi.ID) ([]*types.PCIPool, error)
 }
 
 // NewClient creates a new topo SDK client
@@ -81,21 +99,33 @@ func (c *Client) UpdateCellAspects(ctx context.Context, cellID topoapi.ID, pci u
 			case *e2smrc.NeighborCellItem_RanTypeChoiceNr:
 				// 5G case
 				nPlmnIDByte, nCid, _, err := parse.GetNRMetricKey(v.RanTypeChoiceNr.NRCgi)
+				if err != nil {
+					return err
+				}
 				nPlmnID := decode.PlmnIDToUint32(nPlmnIDByte)
 				nIDObj := &topoapi.NeighborCellID{
 					CellGlobalID: &topoapi.CellGlobalID{
 						Value: fmt.Sprintf("%x", nCid),
+						Type:  topoapi.CellGlobalIDType_NRCGI,
 					},
 					PlmnID: fmt.Sprintf("%x", nPlmnID),
 				}
 				cellObject.NeighborCellIDs = append(cellObject.NeighborCellIDs, nIDObj)
+			case *e2smrc.NeighborCellItem_RanTypeChoiceEutra:
+				// 4G case
+				nPlmnIDByte, nCid, _, err := parse.GetEUTRAMetricKey(v.RanTypeChoiceEutra.EUtraCgi)
 				if err != nil {
 					return err
 				}
-			case *e2smrc.NeighborCellItem_RanTypeChoiceEutra:
-				// 4G case
-				// ToDo: add EUTRA case
-				fmt.Println("4G case is not supported yet")
+				nPlmnID := decode.PlmnIDToUint32(nPlmnIDByte)
+				nIDObj := &topoapi.NeighborCellID{
+					CellGlobalID: &topoapi.CellGlobalID{
+						Value: fmt.Sprintf("%x", nCid),
+						Type:  topoapi.CellGlobalIDType_ECGI,
+					},
+					PlmnID: fmt.Sprintf("%x", nPlmnID),
+				}
+				cellObject.NeighborCellIDs = append(cellObject.NeighborCellIDs, nIDObj)
 			}
 		}
 		cellObject.ARFCN = arfcn
@@ -115,6 +145,41 @@ func (c *Client) UpdateCellAspects(ctx context.Context, cellID topoapi.ID, pci u
 	return nil
 }
 
+// GetCellPCIPools gets the PCI pools set in the aspect of an E2 cell
+func (c *Client) GetCellPCIPools(ctx context.Context, cellID topoapi.ID) ([]*types.PCIPool, error) {
+	object, err := c.client.Get(ctx, cellID)
+	if err != nil {
+		return nil, err
+	}
+	value, err := object.GetAspectBytes(PCIPoolsAspectType)
+	if err != nil {
+		return nil, err
+	}
+	aspect := pciPoolsAspect{}
+	err = json.Unmarshal(value, &aspect)
+	if err != nil {
+		return nil, errors.NewInvalid("failed to parse %s aspect of %s: %v", PCIPoolsAspectType, cellID, err)
+	}
+
+	pools := make([]*types.PCIPool, 0, len(aspect.Pools))
+	for _, pool := range aspect.Pools {
+		pools = append(pools, &types.PCIPool{
+			LowerPci: pool.Lower,
+			UpperPci: pool.Upper,
+		})
+	}
+	return pools, nil
+}
+
+// GetCellTopoID returns the ID of the E2 cell object of a cell in R-NIB
+func GetCellTopoID(nodeID topoapi.ID, cgi *e2smrccomm.Cgi) (topoapi.ID, error) {
+	cellID, err := parse.GetCellID(cgi)
+	if err != nil {
+		return "", err
+	}
+	return topoapi.ID(fmt.Sprintf("%s/%s", nodeID, strconv.FormatUint(cellID, 16))), nil
+}
+
 // GetCells get list of cells for each E2 node
 func (c *Client) GetCells(ctx context.Context, nodeID topoapi.ID) ([]*topoapi.E2Cell, error) {
 	filter := &topoapi.Filters{
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package e2
+
+import (
+	"context"
+	"time"
+
+	e2api "github.com/onosproject/onos-api/go/onos/e2t/e2/v1beta1"
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/onosproject/onos-pci/pkg/utils/control"
+	e2client "github.com/onosproject/onos-ric-sdk-go/pkg/e2/v1beta1"
+	"github.com/onosproject/onos-ric-sdk-go/pkg/e2/v1beta1/e2errors"
+)
+
+const (
+	// DefaultControlRetries is the default number of times a failed RC control message is sent again
+	DefaultControlRetries = 3
+	// DefaultControlBackoff is the default time before the first retry of a failed RC control message
+	DefaultControlBackoff = time.Second
+	// MaxControlBackoff is the maximum time between the retries of a failed RC control message
+	MaxControlBackoff = 30 * time.Second
+)
+
+// transientCauses are the E2AP causes of the RIC control failures that may not happen again
+var transientCauses = map[e2errors.E2APType]bool{
+	e2errors.Unknown:                       true,
+	e2errors.RICUnspecified:                true,
+	e2errors.RICFunctionResourceLimit:      true,
+	e2errors.RICServiceUnspecified:         true,
+	e2errors.RICServiceRICResourceLimit:    true,
+	e2errors.MiscUnspecified:               true,
+	e2errors.MiscControlProcessingOverload: true,
+	e2errors.MiscHardwareFailure:           true,
+}
+
+// causes are the E2AP causes of the error types, named by the cause group and the E2T API enum value
+var causes = map[e2errors.E2APType]string{
+	e2errors.RICUnspecified:                                       ricCause(e2api.Error_Cause_Ric_UNSPECIFIED),
+	e2errors.RICRANFunctionIDInvalid:                              ricCause(e2api.Error_Cause_Ric_RAN_FUNCTION_ID_INVALID),
+	e2errors.RICActionNotSupported:                                ricCause(e2api.Error_Cause_Ric_ACTION_NOT_SUPPORTED),
+	e2errors.RICExcessiveActions:                                  ricCause(e2api.Error_Cause_Ric_EXCESSIVE_ACTIONS),
+	e2errors.RICDuplicateAction:                                   ricCause(e2api.Error_Cause_Ric_DUPLICATE_ACTION),
+	e2errors.RICDuplicateEvent:                                    ricCause(e2api.Error_Cause_Ric_DUPLICATE_EVENT),
+	e2errors.RICFunctionResourceLimit:                             ricCause(e2api.Error_Cause_Ric_FUNCTION_RESOURCE_LIMIT),
+	e2errors.RICRequestIDUnknown:                                  ricCause(e2api.Error_Cause_Ric_REQUEST_ID_UNKNOWN),
+	e2errors.RICInconsistentActionSubsequentActionSequence:        ricCause(e2api.Error_Cause_Ric_INCONSISTENT_ACTION_SUBSEQUENT_ACTION_SEQUENCE),
+	e2errors.RICControlMessageInvalid:                             ricCause(e2api.Error_Cause_Ric_CONTROL_MESSAGE_INVALID),
+	e2errors.RICCallProcessIDInvalid:                              ricCause(e2api.Error_Cause_Ric_CALL_PROCESS_ID_INVALID),
+	e2errors.RICServiceUnspecified:                                ricServiceCause(e2api.Error_Cause_RicService_UNSPECIFIED),
+	e2errors.RICServiceFunctionNotRequired:                        ricServiceCause(e2api.Error_Cause_RicService_FUNCTION_NOT_REQUIRED),
+	e2errors.RICServiceExcessiveFunctions:                         ricServiceCause(e2api.Error_Cause_RicService_EXCESSIVE_FUNCTIONS),
+	e2errors.RICServiceRICResourceLimit:                           ricServiceCause(e2api.Error_Cause_RicService_RIC_RESOURCE_LIMIT),
+	e2errors.ProtocolUnspecified:                                  protocolCause(e2api.Error_Cause_Protocol_UNSPECIFIED),
+	e2errors.ProtocolTransferSyntaxError:                          protocolCause(e2api.Error_Cause_Protocol_TRANSFER_SYNTAX_ERROR),
+	e2errors.ProtocolAbstractSyntaxErrorReject:                    protocolCause(e2api.Error_Cause_Protocol_ABSTRACT_SYNTAX_ERROR_REJECT),
+	e2errors.ProtocolAbstractSyntaxErrorIgnoreAndNotify:           protocolCause(e2api.Error_Cause_Protocol_ABSTRACT_SYNTAX_ERROR_IGNORE_AND_NOTIFY),
+	e2errors.ProtocolMessageNotCompatibleWithReceiverState:        protocolCause(e2api.Error_Cause_Protocol_MESSAGE_NOT_COMPATIBLE_WITH_RECEIVER_STATE),
+	e2errors.ProtocolSemanticError:                                protocolCause(e2api.Error_Cause_Protocol_SEMANTIC_ERROR),
+	e2errors.ProtocolAbstractSyntaxErrorFalselyConstructedMessage: protocolCause(e2api.Error_Cause_Protocol_ABSTRACT_SYNTAX_ERROR_FALSELY_CONSTRUCTED_MESSAGE),
+	e2errors.MiscUnspecified:                                      miscCause(e2api.Error_Cause_Misc_UNSPECIFIED),
+	e2errors.MiscControlProcessingOverload:                        miscCause(e2api.Error_Cause_Misc_CONTROL_PROCESSING_OVERLOAD),
+	e2errors.MiscHardwareFailure:                                  miscCause(e2api.Error_Cause_Misc_HARDWARE_FAILURE),
+	e2errors.MiscOMIntervention:                                   miscCause(e2api.Error_Cause_Misc_OM_INTERVENTION),
+}
+
+// unknownCause is the cause of the RIC control failures without a known E2AP cause
+const unknownCause = "UNKNOWN"
+
+func ricCause(cause e2api.Error_Cause_Ric_Type) string {
+	return "RIC_" + cause.String()
+}
+
+func ricServiceCause(cause e2api.Error_Cause_RicService_Type) string {
+	return "RIC_SERVICE_" + cause.String()
+}
+
+func protocolCause(cause e2api.Error_Cause_Protocol_Type) string {
+	return "PROTOCOL_" + cause.String()
+}
+
+func miscCause(cause e2api.Error_Cause_Misc_Type) string {
+	return "MISC_" + cause.String()
+}
+
+// classifyControlError returns the class of a control message error, its E2AP cause and whether it may be transient;
+// the errors which are not E2AP errors come from E2T, e.g., when it cannot be reached
+func classifyControlError(err error) (types.ControlFailure, string, bool) {
+	typed, ok := err.(*e2errors.TypedError)
+	if !ok {
+		return types.E2TFailure, "", true
+	}
+	cause, ok := causes[typed.E2APType]
+	if !ok {
+		cause = unknownCause
+	}
+	return types.RICControlFailure, cause, transientCauses[typed.E2APType]
+}
+
+// buildPciControl encodes the RC control message setting the PCI of a cell
+func buildPciControl(cgi *e2smrccomm.Cgi, pci int32) (*e2api.ControlMessage, error) {
+	header, err := control.CreateRcControlHeader(cgi)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := control.CreateRcControlMessage(int64(pci), cgi)
+	if err != nil {
+		return nil, err
+	}
+	return &e2api.ControlMessage{
+		Header:  header,
+		Payload: payload,
+	}, nil
+}
+
+// controlPci sends the RC control message setting the PCI of a cell to the E2 node, and sends it again with
+// an exponential backoff after the failures that may be transient. It gives up as superseded once the cell
+// in store was changed again.
+func (m *Manager) controlPci(ctx context.Context, e2nodeID topoapi.ID, key uint64, cgi *e2smrccomm.Cgi, pci int32, changeID uint64) (types.ControlResult, bool) {
+	result := types.ControlResult{PCI: pci, ChangeID: changeID}
+	log.Debugf("send control message for cgi: %v / pci: %v", cgi, pci)
+	message, err := buildPciControl(cgi, pci)
+	if err != nil {
+		result.Failure = types.BuildFailure
+		result.Error = err.Error()
+		return result, false
+	}
+
+	node := m.e2client.Node(e2client.NodeID(e2nodeID))
+	backoff := m.control.Backoff
+	for {
+		result.Attempts++
+		outcome, err := node.Control(ctx, message, nil)
+		if err == nil {
+			result.Failure = types.NoFailure
+			result.Error = ""
+			result.Cause = ""
+			result.Outcome = outcome.GetPayload()
+			result.Acked = time.Now()
+			return result, false
+		}
+		var transient bool
+		result.Failure, result.Cause, transient = classifyControlError(err)
+		result.Error = err.Error()
+		log.Warnf("Control message %v for PCI %v of cell %v failed (%v %v): %v",
+			result.Attempts, pci, key, result.Failure, result.Cause, err)
+		if !transient || result.Attempts > m.control.Retries {
+			return result, false
+		}
+
+		select {
+		case <-ctx.Done():
+			return result, false
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > MaxControlBackoff {
+			backoff = MaxControlBackoff
+		}
+		if entry, err := m.metricStore.Get(ctx, key); err != nil || entry.Value.Metric.ChangeID != changeID {
+			log.Infof("PCI %v of cell %v was superseded before it could be set", pci, key)
+			return result, true
+		}
+	}
+}
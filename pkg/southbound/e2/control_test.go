@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package e2
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	e2api "github.com/onosproject/onos-api/go/onos/e2t/e2/v1beta1"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	e2client "github.com/onosproject/onos-ric-sdk-go/pkg/e2/v1beta1"
+	"github.com/onosproject/onos-ric-sdk-go/pkg/e2/v1beta1/e2errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// testE2Node is an E2 node returning the errors of a function for the control messages
+type testE2Node struct {
+	e2client.Node
+	mu       sync.Mutex
+	attempts int
+	// control returns the error of an attempt; the attempts are counted from 1
+	control func(attempt int) error
+}
+
+// testE2Client is an E2 client with a single test node
+type testE2Client struct {
+	*testE2Node
+}
+
+func (c *testE2Client) Node(e2client.NodeID) e2client.Node {
+	return c.testE2Node
+}
+
+func (c *testE2Node) Control(ctx context.Context, _ *e2api.ControlMessage, _ []byte) (*e2api.ControlOutcome, error) {
+	c.mu.Lock()
+	c.attempts++
+	attempt := c.attempts
+	c.mu.Unlock()
+	if err := c.control(attempt); err != nil {
+		return nil, err
+	}
+	return &e2api.ControlOutcome{Payload: []byte{1}}, ctx.Err()
+}
+
+func newTestControlManager(t *testing.T, client *testE2Client, retries int, backoff time.Duration) (*Manager, metrics.Store) {
+	store := metrics.NewStore()
+	putTestCell(t, store, 1, "e2:1")
+	putTestCell(t, store, 2, "e2:1")
+	return &Manager{
+		e2client:    client,
+		metricStore: store,
+		nodes:       newNodeSessions(),
+		control:     ControlOptions{Retries: retries, Backoff: backoff},
+	}, store
+}
+
+// watchTestPCIChanges starts watching the PCI changes of node e2:1 until the test ends
+func watchTestPCIChanges(t *testing.T, m *Manager) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		m.watchPCIChanges(ctx, "e2:1")
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	assert.Eventually(t, func() bool {
+		return len(m.metricStore.WatcherStats(ctx)) == 1
+	}, time.Second, time.Millisecond)
+}
+
+// lastControl waits for the control result of a change of a cell
+func lastControl(t *testing.T, store metrics.Store, nci byte, changeID uint64) *types.ControlResult {
+	var result *types.ControlResult
+	assert.Eventually(t, func() bool {
+		entry, err := store.Get(context.Background(), metrics.NewKey(testCGI(nci)))
+		if err != nil || entry.Value.LastControl == nil || entry.Value.LastControl.ChangeID != changeID {
+			return false
+		}
+		result = entry.Value.LastControl
+		return true
+	}, 2*time.Second, time.Millisecond)
+	return result
+}
+
+func TestClassifyControlError(t *testing.T) {
+	failure, cause, transient := classifyControlError(fmt.Errorf("connection refused"))
+	assert.Equal(t, types.E2TFailure, failure)
+	assert.Empty(t, cause)
+	assert.True(t, transient)
+
+	failure, cause, transient = classifyControlError(e2errors.NewMiscControlProcessingOverload("overload"))
+	assert.Equal(t, types.RICControlFailure, failure)
+	assert.Equal(t, "MISC_CONTROL_PROCESSING_OVERLOAD", cause)
+	assert.True(t, transient)
+
+	failure, cause, transient = classifyControlError(e2errors.NewRICControlMessageInvalid("invalid"))
+	assert.Equal(t, types.RICControlFailure, failure)
+	assert.Equal(t, "RIC_CONTROL_MESSAGE_INVALID", cause)
+	assert.False(t, transient)
+
+	_, cause, _ = classifyControlError(e2errors.NewRICServiceRICResourceLimit("limit"))
+	assert.Equal(t, "RIC_SERVICE_RIC_RESOURCE_LIMIT", cause)
+	_, cause, transient = classifyControlError(e2errors.NewUnknown("unknown"))
+	assert.Equal(t, unknownCause, cause)
+	assert.True(t, transient)
+	// every E2AP error type has a cause
+	for errorType := e2errors.RICUnspecified; errorType <= e2errors.MiscOMIntervention; errorType++ {
+		assert.Contains(t, causes, errorType)
+	}
+}
+
+func TestControlBackoff(t *testing.T) {
+	client := &testE2Client{&testE2Node{control: func(attempt int) error {
+		if attempt <= 2 {
+			return e2errors.NewMiscControlProcessingOverload("overload")
+		}
+		return nil
+	}}}
+	m, store := newTestControlManager(t, client, 3, 20*time.Millisecond)
+	watchTestPCIChanges(t, m)
+
+	// the failed control messages are sent again after 20ms, then 40ms
+	start := time.Now()
+	assert.NoError(t, store.UpdatePci(context.Background(), metrics.NewKey(testCGI(1)), 5, 1))
+	result := lastControl(t, store, 1, 1)
+	assert.True(t, time.Since(start) >= 60*time.Millisecond)
+	assert.Equal(t, types.NoFailure, result.Failure)
+	assert.Equal(t, 3, result.Attempts)
+	assert.Equal(t, []byte{1}, result.Outcome)
+	assert.False(t, result.RolledBack)
+}
+
+func TestControlRollback(t *testing.T) {
+	client := &testE2Client{&testE2Node{control: func(attempt int) error {
+		return e2errors.NewMiscHardwareFailure("failure")
+	}}}
+	m, store := newTestControlManager(t, client, 1, time.Millisecond)
+	watchTestPCIChanges(t, m)
+
+	// the cell is rolled back to its previous PCI after the retries failed
+	assert.NoError(t, store.UpdatePci(context.Background(), metrics.NewKey(testCGI(1)), 5, 1))
+	result := lastControl(t, store, 1, 1)
+	assert.Equal(t, types.RICControlFailure, result.Failure)
+	assert.Equal(t, "MISC_HARDWARE_FAILURE", result.Cause)
+	assert.Equal(t, 2, result.Attempts)
+	assert.True(t, result.RolledBack)
+	entry, err := store.Get(context.Background(), metrics.NewKey(testCGI(1)))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), entry.Value.Metric.PCI)
+
+	// the failures which are not transient are not retried
+	client.control = func(attempt int) error {
+		return e2errors.NewRICControlMessageInvalid("invalid")
+	}
+	assert.NoError(t, store.UpdatePci(context.Background(), metrics.NewKey(testCGI(2)), 5, 2))
+	result = lastControl(t, store, 2, 2)
+	assert.Equal(t, 1, result.Attempts)
+	assert.True(t, result.RolledBack)
+}
+
+func TestControlSuperseded(t *testing.T) {
+	ctx := context.Background()
+	failing := make(chan bool, 1)
+	failing <- true
+	client := &testE2Client{&testE2Node{control: func(attempt int) error {
+		fail := <-failing
+		failing <- fail
+		if fail {
+			return e2errors.NewMiscControlProcessingOverload("overload")
+		}
+		return nil
+	}}}
+	m, store := newTestControlManager(t, client, 100, 50*time.Millisecond)
+	watchTestPCIChanges(t, m)
+
+	// the retries of a cell do not hold up the changes of the other cells
+	assert.NoError(t, store.UpdatePci(ctx, metrics.NewKey(testCGI(1)), 5, 1))
+	assert.Eventually(t, func() bool {
+		client.mu.Lock()
+		defer client.mu.Unlock()
+		return client.attempts > 0
+	}, time.Second, time.Millisecond)
+	<-failing
+	failing <- false
+	assert.NoError(t, store.UpdatePci(ctx, metrics.NewKey(testCGI(2)), 6, 2))
+	assert.Equal(t, types.NoFailure, lastControl(t, store, 2, 2).Failure)
+	<-failing
+	failing <- true
+
+	// a new change of the retried cell supersedes the retried change, which is not rolled back
+	ch := make(chan metrics.Event, 10)
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	assert.NoError(t, store.Watch(watchCtx, ch, metrics.WithEventTypes(metrics.ControlResult)))
+	<-failing
+	failing <- false
+	assert.NoError(t, store.UpdatePci(ctx, metrics.NewKey(testCGI(1)), 7, 3))
+	superseded := (<-ch).Value.Value.LastControl
+	assert.Equal(t, uint64(1), superseded.ChangeID)
+	assert.False(t, superseded.RolledBack)
+	result := lastControl(t, store, 1, 3)
+	assert.Equal(t, types.NoFailure, result.Failure)
+	entry, err := store.Get(ctx, metrics.NewKey(testCGI(1)))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), entry.Value.Metric.PCI)
+}
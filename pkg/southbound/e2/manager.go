@@ -8,12 +8,14 @@ package e2
 import (
 	"context"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/onosproject/onos-pci/pkg/monitoring"
-
-	"github.com/onosproject/onos-pci/pkg/utils/control"
+	"github.com/onosproject/onos-pci/pkg/pools"
 
 	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
 
 	prototypes "github.com/gogo/protobuf/types"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
@@ -36,6 +38,9 @@ var log = logging.GetLogger()
 
 const (
 	oid = "1.3.6.1.4.1.53148.1.1.2.3"
+
+	// closeStreamTimeout is how long closing the subscription of a disconnected E2 node may take
+	closeStreamTimeout = 10 * time.Second
 )
 
 // Node e2 manager interface
@@ -52,11 +57,19 @@ type Manager struct {
 	appConfig    *appConfig.AppConfig
 	streams      broker.Broker
 	metricStore  metrics.Store
+	pciPools     *pools.Provider
+	nodes        *nodeSessions
+	control      ControlOptions
 }
 
 // NewManager creates a new subscription manager
 func NewManager(opts ...Option) (Manager, error) {
-	options := Options{}
+	options := Options{
+		Control: ControlOptions{
+			Retries: DefaultControlRetries,
+			Backoff: DefaultControlBackoff,
+		},
+	}
 
 	for _, opt := range opts {
 		opt.apply(&options)
@@ -85,6 +98,9 @@ func NewManager(opts ...Option) (Manager, error) {
 		appConfig:   options.App.AppConfig,
 		streams:     options.App.Broker,
 		metricStore: options.App.MetricStore,
+		pciPools:    pools.NewProvider(options.App.AppConfig, rnibClient),
+		nodes:       newNodeSessions(),
+		control:     options.Control,
 	}, nil
 
 }
@@ -94,7 +110,11 @@ func (m *Manager) Start() error {
 	go func() {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
-		err := m.watchE2Connections(ctx)
+		err := m.pciPools.Watch(ctx, m.metricStore)
+		if err != nil {
+			log.Warn(err)
+		}
+		err = m.watchE2Connections(ctx)
 		if err != nil {
 			return
 		}
@@ -180,18 +200,29 @@ func (m *Manager) createSubscription(ctx context.Context, e2nodeID topoapi.ID) e
 		return err
 	}
 
+	defer func() {
+		// the context is done once the E2 node is disconnected, so the stream is closed with a new one
+		closeCtx, cancel := context.WithTimeout(context.Background(), closeStreamTimeout)
+		defer cancel()
+		if _, err := m.streams.CloseStream(closeCtx, channelID); err != nil {
+			log.Warnf("Closing subscription of E2 node %v: %v", e2nodeID, err)
+		}
+	}()
+
 	go m.sendIndicationOnStream(streamReader.StreamID(), ch)
 	monitor := monitoring.NewMonitor(monitoring.WithAppConfig(m.appConfig),
 		monitoring.WithMetricStore(m.metricStore),
 		monitoring.WithNode(node),
 		monitoring.WithStreamReader(streamReader),
 		monitoring.WithNodeID(e2nodeID),
-		monitoring.WithRNIBClient(m.rnibClient))
+		monitoring.WithRNIBClient(m.rnibClient),
+		monitoring.WithPCIPools(m.pciPools))
 
 	err = monitor.Start(ctx)
-	if err != nil {
+	if err != nil && ctx.Err() == nil {
 		log.Warn(err)
 	}
+	log.Infof("Stopped monitoring E2 node %v", e2nodeID)
 
 	return nil
 
@@ -210,63 +241,181 @@ func (m *Manager) watchE2Connections(ctx context.Context) error {
 		return err
 	}
 
-	// creates a new subscription whenever there is a new E2 node connected and supports KPM service model
+	// creates a new subscription whenever there is a new E2 node connected and supports KPM service model,
+	// and removes it with the node's cells when the node is disconnected
 	for topoEvent := range ch {
-		if topoEvent.Type == topoapi.EventType_ADDED || topoEvent.Type == topoapi.EventType_NONE {
+		relation, ok := topoEvent.Object.Obj.(*topoapi.Object_Relation)
+		if !ok {
+			continue
+		}
+		e2NodeID := relation.Relation.TgtEntityID
+		switch topoEvent.Type {
+		case topoapi.EventType_ADDED, topoapi.EventType_NONE:
 			log.Infof("New E2 connection detected")
-			relation := topoEvent.Object.Obj.(*topoapi.Object_Relation)
-			e2NodeID := relation.Relation.TgtEntityID
-
 			if !m.rnibClient.HasRCRANFunction(ctx, e2NodeID, oid) {
 				log.Debugf("Received topo event does not have RC RAN function - %v", topoEvent)
 				continue
 			}
+			m.startNode(ctx, e2NodeID)
+		case topoapi.EventType_REMOVED:
+			log.Infof("E2 connection removed: %v", e2NodeID)
+			m.stopNode(ctx, e2NodeID)
+		}
+	}
+	return nil
+}
 
-			go func() {
-				log.Debugf("start creating subscriptions %v", topoEvent)
-				err := m.newSubscription(ctx, e2NodeID)
-				if err != nil {
-					log.Warn(err)
-				}
-			}()
-			go m.watchPCIChanges(ctx, e2NodeID)
+// startNode subscribes to an E2 node and starts watching the PCI changes of its cells; if the node is still
+// stopping from its last connection, it is subscribed once the cells of that connection are removed
+func (m *Manager) startNode(ctx context.Context, e2NodeID topoapi.ID) {
+	nodeCtx, cancel := context.WithCancel(ctx)
+	session := newNodeSession(cancel)
+	if !m.nodes.add(e2NodeID, session) {
+		cancel()
+		log.Debugf("E2 node %v is already subscribed", e2NodeID)
+		return
+	}
+
+	session.running.Add(2)
+	go func() {
+		defer session.running.Done()
+		if session.previous != nil {
+			select {
+			case <-session.previous.stopped:
+			case <-nodeCtx.Done():
+				return
+			}
+		}
+		log.Debugf("start creating subscriptions for %v", e2NodeID)
+		err := m.newSubscription(nodeCtx, e2NodeID)
+		if err != nil {
+			log.Warn(err)
 		}
+	}()
+	go func() {
+		defer session.running.Done()
+		m.watchPCIChanges(nodeCtx, e2NodeID)
+	}()
+}
 
+// stopNode closes the subscription of a disconnected E2 node, stops its monitor and PCI watcher and removes its cells;
+// the cells are removed once the monitor and the watcher are stopped, so that no indication message adds them back.
+// It does not wait for them, so that the other E2 connection events are not held up.
+func (m *Manager) stopNode(ctx context.Context, e2NodeID topoapi.ID) {
+	session, ok := m.nodes.remove(e2NodeID)
+	if !ok {
+		return
 	}
-	return nil
+	session.cancel()
+	go func() {
+		session.running.Wait()
+		m.removeCells(ctx, e2NodeID)
+		m.nodes.stopped(e2NodeID, session)
+	}()
+}
+
+// removeCells removes the cells of an E2 node from the store, which notifies the watchers with Deleted events
+func (m *Manager) removeCells(ctx context.Context, e2NodeID topoapi.ID) {
+	ch := make(chan *metrics.Entry, 1024)
+	go func() {
+		// the error is NotFound if the store is empty
+		_ = m.metricStore.Entries(ctx, ch)
+	}()
+	keys := make([]uint64, 0)
+	for entry := range ch {
+		if entry.Value.E2NodeID == e2NodeID {
+			keys = append(keys, metrics.NewKey(entry.Key.CellGlobalID))
+		}
+	}
+	for _, key := range keys {
+		if err := m.metricStore.Delete(ctx, key); err != nil {
+			log.Warn(err)
+		}
+	}
+	log.Infof("Removed %v cells of E2 node %v", len(keys), e2NodeID)
+}
+
+// cellControl is the RC control of a PCI change of a cell, with its retries
+type cellControl struct {
+	cancel context.CancelFunc
+	// done is closed once the outcome of the control is recorded
+	done chan struct{}
 }
 
+// watchPCIChanges sends the RC control messages of the PCI changes of the cells of an E2 node; the changes of
+// the cells are controlled in parallel, so that the retries of a cell do not hold up the other cells, and a new
+// change of a cell supersedes the one still controlled. It returns once all controls are done.
 func (m *Manager) watchPCIChanges(ctx context.Context, e2nodeID topoapi.ID) {
 	ch := make(chan metrics.Event)
-	err := m.metricStore.Watch(ctx, ch)
+	err := m.metricStore.Watch(ctx, ch, metrics.WithEventTypes(metrics.UpdatedPCI), metrics.WithE2NodeIDs(e2nodeID))
 	if err != nil {
 		return
 	}
 
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	var mu sync.Mutex
+	controls := make(map[uint64]*cellControl)
+	// the PCI updates of the cells of the E2 node are never dropped
 	for e := range ch {
-		if e.Type == metrics.UpdatedPCI && e2nodeID == e.Value.Value.E2NodeID {
-			key := e.Value.Key
-			header, err := control.CreateRcControlHeader(key.CellGlobalID)
-			if err != nil {
-				log.Warn(err)
-			}
-			newPci := e.Value.Value.Metric.PCI
-			log.Debugf("send control message for key: %v / pci: %v", e.Key, newPci)
-			payload, err := control.CreateRcControlMessage(int64(newPci), key.CellGlobalID)
-			if err != nil {
-				log.Warn(err)
-			}
+		cellCtx, cancel := context.WithCancel(ctx)
+		control := &cellControl{
+			cancel: cancel,
+			done:   make(chan struct{}),
+		}
+		mu.Lock()
+		previous := controls[e.Key]
+		controls[e.Key] = control
+		mu.Unlock()
+		if previous != nil {
+			previous.cancel()
+		}
 
-			node := m.e2client.Node(e2client.NodeID(e2nodeID))
-			outcome, err := node.Control(ctx, &e2api.ControlMessage{
-				Header:  header,
-				Payload: payload,
-			}, nil)
-			if err != nil {
-				log.Warn(err)
+		wg.Add(1)
+		go func(e metrics.Event) {
+			defer wg.Done()
+			defer func() {
+				cancel()
+				mu.Lock()
+				if controls[e.Key] == control {
+					delete(controls, e.Key)
+				}
+				mu.Unlock()
+				close(control.done)
+			}()
+			// the control messages of a cell are sent in the order of its changes
+			if previous != nil {
+				<-previous.done
 			}
-			log.Infof("Outcome:%v", outcome)
+			m.applyPciChange(ctx, cellCtx, e2nodeID, e)
+		}(e)
+	}
+}
+
+// applyPciChange sends the RC control message of a PCI change of a cell, and records its outcome or rolls
+// the cell back after its final failure; cellCtx is cancelled once the change is superseded
+func (m *Manager) applyPciChange(ctx context.Context, cellCtx context.Context, e2nodeID topoapi.ID, e metrics.Event) {
+	result, superseded := m.controlPci(cellCtx, e2nodeID, e.Key, e.Value.Key.CellGlobalID,
+		e.Value.Value.Metric.PCI, e.Value.Value.Metric.ChangeID)
+	if ctx.Err() != nil {
+		return
+	}
+	if cellCtx.Err() != nil {
+		log.Infof("PCI %v of cell %v was superseded before it could be set", result.PCI, e.Key)
+		superseded = true
+	}
+	if result.Failure != types.NoFailure && !superseded {
+		// the store is not left claiming a PCI the cell never adopted
+		log.Warnf("PCI %v of cell %v could not be set after %v attempts, rolling back: %v",
+			result.PCI, e.Key, result.Attempts, result.Error)
+		if err := m.metricStore.RollbackPci(ctx, e.Key, result); err != nil {
+			log.Warn(err)
 		}
+		return
+	}
+	// the outcome is recorded so that the caller of a manual PCI change can get it
+	if err := m.metricStore.SetControlResult(ctx, e.Key, result); err != nil {
+		log.Warn(err)
 	}
 }
 
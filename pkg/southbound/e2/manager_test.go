@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package e2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	"github.com/onosproject/onos-lib-go/api/asn1/v1/asn1"
+	"github.com/onosproject/onos-pci/pkg/store/metrics"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testCGI(nci byte) *e2smrccomm.Cgi {
+	return &e2smrccomm.Cgi{Cgi: &e2smrccomm.Cgi_NRCgi{NRCgi: &e2smrccomm.NrCgi{
+		PLmnidentity: &e2smrccomm.Plmnidentity{Value: []byte{0x21, 0xf3, 0x54}},
+		NRcellIdentity: &e2smrccomm.NrcellIdentity{
+			Value: &asn1.BitString{Value: []byte{0x00, 0x00, 0x00, 0x00, nci << 4}, Len: 36},
+		},
+	}}}
+}
+
+func putTestCell(t *testing.T, store metrics.Store, nci byte, e2NodeID topoapi.ID) uint64 {
+	cgi := testCGI(nci)
+	key := metrics.NewKey(cgi)
+	_, err := store.Put(context.Background(), key, metrics.Entry{
+		Key: metrics.Key{CellGlobalID: cgi},
+		Value: types.CellPCI{
+			E2NodeID: e2NodeID,
+			Metric:   &types.CellMetric{PCI: int32(nci)},
+		},
+	})
+	assert.NoError(t, err)
+	return key
+}
+
+func TestRemoveCells(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	m := &Manager{metricStore: store, nodes: newNodeSessions()}
+	key1 := putTestCell(t, store, 1, "e2:1")
+	key2 := putTestCell(t, store, 2, "e2:1")
+	key3 := putTestCell(t, store, 3, "e2:2")
+	ch := make(chan metrics.Event, 10)
+	assert.NoError(t, store.Watch(ctx, ch, metrics.WithEventTypes(metrics.Deleted)))
+
+	m.removeCells(ctx, "e2:1")
+	deleted := []uint64{(<-ch).Key, (<-ch).Key}
+	assert.ElementsMatch(t, []uint64{key1, key2}, deleted)
+	_, err := store.Get(ctx, key3)
+	assert.NoError(t, err)
+
+	// removing the cells of a node without cells or of an empty store does nothing
+	m.removeCells(ctx, "e2:1")
+	m.removeCells(ctx, "e2:2")
+	m.removeCells(ctx, "e2:2")
+	assert.Equal(t, key3, (<-ch).Key)
+	assert.Len(t, ch, 0)
+}
+
+func TestStopNode(t *testing.T) {
+	ctx := context.Background()
+	store := metrics.NewStore()
+	m := &Manager{metricStore: store, nodes: newNodeSessions()}
+	putTestCell(t, store, 1, "e2:1")
+	putTestCell(t, store, 2, "e2:2")
+
+	// the monitor of the node adds a cell until it is stopped
+	nodeCtx, cancel := context.WithCancel(ctx)
+	session := newNodeSession(cancel)
+	assert.True(t, m.nodes.add("e2:1", session))
+	assert.False(t, m.nodes.add("e2:1", newNodeSession(cancel)))
+	release := make(chan struct{})
+	session.running.Add(1)
+	go func() {
+		defer session.running.Done()
+		<-nodeCtx.Done()
+		<-release
+		putTestCell(t, store, 3, "e2:1")
+	}()
+
+	// stopping the node cancels its session without waiting for its monitor
+	m.stopNode(ctx, "e2:1")
+	m.stopNode(ctx, "e2:1")
+	assert.Error(t, nodeCtx.Err())
+	select {
+	case <-session.stopped:
+		assert.Fail(t, "session stopped before its monitor")
+	default:
+	}
+
+	// a new connection of the node waits for the cells of the last one to be removed
+	next := newNodeSession(func() {})
+	assert.True(t, m.nodes.add("e2:1", next))
+	assert.Equal(t, session, next.previous)
+
+	close(release)
+	select {
+	case <-session.stopped:
+	case <-time.After(time.Second):
+		assert.Fail(t, "session not stopped")
+	}
+	for nci := byte(1); nci <= 3; nci++ {
+		_, err := store.Get(ctx, metrics.NewKey(testCGI(nci)))
+		assert.Equal(t, nci == 2, err == nil)
+	}
+	assert.Empty(t, m.nodes.stopping)
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package e2
+
+import (
+	"context"
+	"sync"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+)
+
+// nodeSession is the subscription, the monitor and the PCI watcher of a connected E2 node
+type nodeSession struct {
+	cancel context.CancelFunc
+	// running tracks the subscription with the monitor, and the PCI watcher
+	running sync.WaitGroup
+	// stopped is closed once the session is stopped and the cells of the node are removed
+	stopped chan struct{}
+	// previous is the stopping session of the node when it connected again, if any
+	previous *nodeSession
+}
+
+func newNodeSession(cancel context.CancelFunc) *nodeSession {
+	return &nodeSession{
+		cancel:  cancel,
+		stopped: make(chan struct{}),
+	}
+}
+
+// nodeSessions are the sessions of the connected E2 nodes and the sessions of the disconnected ones still stopping
+type nodeSessions struct {
+	sessions map[topoapi.ID]*nodeSession
+	stopping map[topoapi.ID]*nodeSession
+	mu       sync.Mutex
+}
+
+func newNodeSessions() *nodeSessions {
+	return &nodeSessions{
+		sessions: make(map[topoapi.ID]*nodeSession),
+		stopping: make(map[topoapi.ID]*nodeSession),
+	}
+}
+
+// add adds the session of an E2 node unless the node has one already; the session gets the stopping session
+// of the node as its previous session
+func (n *nodeSessions) add(nodeID topoapi.ID, session *nodeSession) bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if _, ok := n.sessions[nodeID]; ok {
+		return false
+	}
+	session.previous = n.stopping[nodeID]
+	n.sessions[nodeID] = session
+	return true
+}
+
+// remove removes the session of an E2 node, which is stopping until it is marked stopped
+func (n *nodeSessions) remove(nodeID topoapi.ID) (*nodeSession, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	session, ok := n.sessions[nodeID]
+	if ok {
+		delete(n.sessions, nodeID)
+		n.stopping[nodeID] = session
+	}
+	return session, ok
+}
+
+// stopped marks the removed session of an E2 node stopped
+func (n *nodeSessions) stopped(nodeID topoapi.ID, session *nodeSession) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.stopping[nodeID] == session {
+		delete(n.stopping, nodeID)
+	}
+	close(session.stopped)
+}
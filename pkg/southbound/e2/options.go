@@ -5,6 +5,8 @@
 package e2
 
 import (
+	"time"
+
 	"github.com/onosproject/onos-pci/pkg/broker"
 	appConfig "github.com/onosproject/onos-pci/pkg/config"
 	"github.com/onosproject/onos-pci/pkg/store/metrics"
@@ -19,6 +21,16 @@ type Options struct {
 	ServiceModel ServiceModelOptions
 
 	App AppOptions
+
+	Control ControlOptions
+}
+
+// ControlOptions are the options for the RC control messages setting the PCIs
+type ControlOptions struct {
+	// Retries is the number of times a failed control message is sent again
+	Retries int
+	// Backoff is the time before the first retry; it doubles for each retry, up to MaxControlBackoff
+	Backoff time.Duration
 }
 
 // AppOptions application options
@@ -163,3 +175,11 @@ func WithMetricStore(metricStore metrics.Store) Option {
 		options.App.MetricStore = metricStore
 	})
 }
+
+// WithControlRetry sets how many times and after how long a failed RC control message is sent again
+func WithControlRetry(retries int, backoff time.Duration) Option {
+	return newOption(func(options *Options) {
+		options.Control.Retries = retries
+		options.Control.Backoff = backoff
+	})
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/types"
+)
+
+// DefaultLimit is the default number of PCI changes kept per cell
+const DefaultLimit = 100
+
+// Trigger is what triggered a PCI change
+type Trigger int
+
+const (
+	// Conflict changes resolve a collision or confusion detected when an indication message arrived
+	Conflict Trigger = iota
+	// Optimization changes are made by the optimizer
+	Optimization
+	// Manual changes are set by the operator
+	Manual
+	// Proposal changes are proposals of the dry-run mode approved by the operator
+	Proposal
+	// Rollback changes set the PCI back to the previous PCI after the RC control message of a change finally
+	// failed; no RC control message is sent for them
+	Rollback
+)
+
+func (t Trigger) String() string {
+	return [...]string{"Conflict", "Optimization", "Manual", "Proposal", "Rollback"}[t]
+}
+
+// Actor is who made a PCI change
+type Actor int
+
+const (
+	// Controller is the PCI controller
+	Controller Actor = iota
+	// Operator is the operator through the northbound API
+	Operator
+)
+
+func (a Actor) String() string {
+	return [...]string{"Controller", "Operator"}[a]
+}
+
+// Verification is whether the next indication message of the cell reported the new PCI
+type Verification int
+
+const (
+	// NotVerified changes are waiting for the control outcome or the next indication message,
+	// or their control message failed
+	NotVerified Verification = iota
+	// Confirmed changes were reported by the next indication message
+	Confirmed
+	// RevertedByNode changes were not reported by the next indication message, which had another PCI
+	RevertedByNode
+	// TimedOut changes were not followed by an indication message in time
+	TimedOut
+)
+
+func (v Verification) String() string {
+	return [...]string{"NotVerified", "Confirmed", "RevertedByNode", "TimedOut"}[v]
+}
+
+// Record is a PCI change of a cell
+type Record struct {
+	CellID uint64
+	Time   time.Time
+	OldPCI int32
+	NewPCI int32
+	// ChangeID identifies the change in the outcome of its RC control message
+	ChangeID uint64
+	// Trigger is what triggered the change and Actor is who made it
+	Trigger Trigger
+	Actor   Actor
+	// ConflictingCells are the keys of the cells the cell was in conflict with, if any
+	ConflictingCells []uint64
+	// Outcome is the outcome of the RC control message; nil until it is received
+	Outcome *types.ControlResult
+	// Verification is whether the change was reported by the next indication message, and ReportedPCI is its PCI
+	Verification Verification
+	ReportedPCI  int32
+}
+
+// Store PCI change history store interface
+type Store interface {
+	// Add adds a PCI change; the oldest changes of the cell beyond the limit are dropped
+	Add(ctx context.Context, record Record) error
+
+	// Remove removes the change of a cell with a change ID, e.g., after the change could not be made
+	Remove(ctx context.Context, cellID uint64, changeID uint64) error
+
+	// SetOutcome sets the control outcome of the change of a cell identified by the outcome
+	SetOutcome(ctx context.Context, cellID uint64, outcome types.ControlResult) error
+
+	// SetVerification sets the verification of the latest change of a cell to a PCI with the PCI reported by the cell
+	SetVerification(ctx context.Context, cellID uint64, pci int32, verification Verification, reportedPCI int32) error
+
+	// List lists the changes of a cell, or of all cells if cellID is 0, made within [start, end] ordered by time;
+	// a zero start or end leaves the time range open on that side
+	List(ctx context.Context, cellID uint64, start time.Time, end time.Time) ([]*Record, error)
+}
+
+type store struct {
+	records map[uint64][]Record
+	limit   int
+	mu      sync.RWMutex
+}
+
+// NewStore creates new store which keeps the last limit changes per cell
+func NewStore(limit int) Store {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	return &store{
+		records: make(map[uint64][]Record),
+		limit:   limit,
+	}
+}
+
+func (s *store) Add(_ context.Context, record Record) error {
+	if record.Time.IsZero() {
+		record.Time = time.Now()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := append(s.records[record.CellID], record)
+	if len(records) > s.limit {
+		records = records[len(records)-s.limit:]
+	}
+	s.records[record.CellID] = records
+	return nil
+}
+
+func (s *store) Remove(_ context.Context, cellID uint64, changeID uint64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := s.records[cellID]
+	for i := len(records) - 1; i >= 0; i-- {
+		if records[i].ChangeID == changeID {
+			s.records[cellID] = append(records[:i:i], records[i+1:]...)
+			return nil
+		}
+	}
+	return errors.NewNotFound("no PCI change %v of cell %v", changeID, cellID)
+}
+
+func (s *store) SetOutcome(_ context.Context, cellID uint64, outcome types.ControlResult) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := s.records[cellID]
+	for i := len(records) - 1; i >= 0; i-- {
+		if records[i].ChangeID == outcome.ChangeID && records[i].NewPCI == outcome.PCI {
+			if records[i].Outcome != nil {
+				break
+			}
+			records[i].Outcome = &outcome
+			return nil
+		}
+	}
+	return errors.NewNotFound("no PCI change %v of cell %v to %v is waiting for the control outcome",
+		outcome.ChangeID, cellID, outcome.PCI)
+}
+
+func (s *store) SetVerification(_ context.Context, cellID uint64, pci int32, verification Verification, reportedPCI int32) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := s.records[cellID]
+	for i := len(records) - 1; i >= 0; i-- {
+		if records[i].NewPCI == pci {
+			records[i].Verification = verification
+			records[i].ReportedPCI = reportedPCI
+			return nil
+		}
+	}
+	return errors.NewNotFound("no PCI change of cell %v to %v", cellID, pci)
+}
+
+func (s *store) List(_ context.Context, cellID uint64, start time.Time, end time.Time) ([]*Record, error) {
+	if !start.IsZero() && !end.IsZero() && end.Before(start) {
+		return nil, errors.NewInvalid("the end of the time range is before the start")
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make([]*Record, 0)
+	for id, records := range s.records {
+		if cellID != 0 && id != cellID {
+			continue
+		}
+		for _, r := range records {
+			if (!start.IsZero() && r.Time.Before(start)) || (!end.IsZero() && r.Time.After(end)) {
+				continue
+			}
+			r := r
+			out = append(out, &r)
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Time.Before(out[j].Time)
+	})
+	return out, nil
+}
+
+var _ Store = &store{}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package history
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore(2)
+	now := time.Now()
+	assert.NoError(t, s.Add(ctx, Record{CellID: 1, Time: now.Add(-3 * time.Hour), OldPCI: 1, NewPCI: 2}))
+	assert.NoError(t, s.Add(ctx, Record{CellID: 1, Time: now.Add(-2 * time.Hour), OldPCI: 2, NewPCI: 3}))
+	assert.NoError(t, s.Add(ctx, Record{CellID: 2, Time: now.Add(-90 * time.Minute), OldPCI: 5, NewPCI: 6,
+		Trigger: Manual, Actor: Operator}))
+	assert.NoError(t, s.Add(ctx, Record{CellID: 1, Time: now.Add(-time.Hour), OldPCI: 3, NewPCI: 4,
+		ConflictingCells: []uint64{2}}))
+
+	// the oldest change of cell 1 is dropped beyond the limit
+	records, err := s.List(ctx, 1, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, int32(3), records[0].NewPCI)
+	assert.Equal(t, int32(4), records[1].NewPCI)
+	assert.Equal(t, []uint64{2}, records[1].ConflictingCells)
+
+	records, err = s.List(ctx, 0, now.Add(-100*time.Minute), now.Add(-30*time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, records, 2)
+	assert.Equal(t, uint64(2), records[0].CellID)
+	assert.Equal(t, Operator, records[0].Actor)
+	assert.Equal(t, uint64(1), records[1].CellID)
+
+	_, err = s.List(ctx, 0, now, now.Add(-time.Hour))
+	assert.True(t, errors.IsInvalid(err))
+}
+
+func TestSetOutcome(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore(DefaultLimit)
+	assert.NoError(t, s.Add(ctx, Record{CellID: 1, OldPCI: 1, NewPCI: 2}))
+	assert.True(t, errors.IsNotFound(s.SetOutcome(ctx, 1, types.ControlResult{PCI: 3})))
+	assert.NoError(t, s.SetOutcome(ctx, 1, types.ControlResult{PCI: 2, Error: "timeout"}))
+	// the outcome of a change is only set once
+	assert.True(t, errors.IsNotFound(s.SetOutcome(ctx, 1, types.ControlResult{PCI: 2})))
+	// the outcome of a change is not taken for another change to the same PCI
+	assert.NoError(t, s.Add(ctx, Record{CellID: 2, OldPCI: 1, NewPCI: 2, ChangeID: 1}))
+	assert.NoError(t, s.Add(ctx, Record{CellID: 2, OldPCI: 2, NewPCI: 1, ChangeID: 2}))
+	assert.NoError(t, s.Add(ctx, Record{CellID: 2, OldPCI: 1, NewPCI: 2, ChangeID: 3}))
+	assert.NoError(t, s.SetOutcome(ctx, 2, types.ControlResult{PCI: 2, ChangeID: 1}))
+	records, err := s.List(ctx, 2, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.NotNil(t, records[0].Outcome)
+	assert.Nil(t, records[2].Outcome)
+
+	records, err = s.List(ctx, 1, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "timeout", records[0].Outcome.Error)
+}
+
+func TestSetVerification(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore(DefaultLimit)
+	assert.NoError(t, s.Add(ctx, Record{CellID: 1, OldPCI: 1, NewPCI: 2}))
+	assert.NoError(t, s.Add(ctx, Record{CellID: 1, OldPCI: 2, NewPCI: 3}))
+	assert.True(t, errors.IsNotFound(s.SetVerification(ctx, 1, 4, Confirmed, 4)))
+	assert.NoError(t, s.SetVerification(ctx, 1, 3, RevertedByNode, 2))
+
+	records, err := s.List(ctx, 1, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, NotVerified, records[0].Verification)
+	assert.Equal(t, RevertedByNode, records[1].Verification)
+	assert.Equal(t, int32(2), records[1].ReportedPCI)
+}
+
+func TestRemove(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore(DefaultLimit)
+	assert.NoError(t, s.Add(ctx, Record{CellID: 1, OldPCI: 1, NewPCI: 2, ChangeID: 1}))
+	assert.NoError(t, s.Add(ctx, Record{CellID: 1, OldPCI: 2, NewPCI: 3, ChangeID: 2}))
+	assert.True(t, errors.IsNotFound(s.Remove(ctx, 1, 3)))
+	assert.NoError(t, s.Remove(ctx, 1, 1))
+
+	records, err := s.List(ctx, 1, time.Time{}, time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, records, 1)
+	assert.Equal(t, uint64(2), records[0].ChangeID)
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"sort"
+
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	"github.com/onosproject/onos-pci/pkg/utils/decode"
+	"github.com/onosproject/onos-pci/pkg/utils/parse"
+)
+
+// cgiID is the value of a CGI; unlike the store key, the NR and EUTRA CGIs never share a value
+type cgiID struct {
+	cgiType parse.CGIType
+	plmnID  uint32
+	cellID  uint64
+}
+
+func newCGIID(cgi *e2smrccomm.Cgi) (cgiID, bool) {
+	var plmnID []byte
+	var cellID uint64
+	var cgiType parse.CGIType
+	var err error
+	if cgi.GetNRCgi() != nil {
+		plmnID, cellID, cgiType, err = parse.GetNRMetricKey(cgi.GetNRCgi())
+	} else if cgi.GetEUtraCgi() != nil {
+		plmnID, cellID, cgiType, err = parse.GetEUTRAMetricKey(cgi.GetEUtraCgi())
+	} else {
+		return cgiID{}, false
+	}
+	if err != nil || len(plmnID) != 3 {
+		return cgiID{}, false
+	}
+	return cgiID{
+		cgiType: cgiType,
+		plmnID:  decode.PlmnIDToUint32(plmnID),
+		cellID:  cellID,
+	}, true
+}
+
+// index is the secondary index of the store: the key of each CGI and the neighbor relations in both directions,
+// so that the neighbors are found without scanning all entries
+type index struct {
+	keys map[cgiID]uint64
+	// neighbors are the neighbor CGIs listed by each entry
+	neighbors map[uint64][]cgiID
+	// listedBy are the keys of the entries listing each CGI as a neighbor
+	listedBy map[cgiID]map[uint64]bool
+}
+
+func newIndex() *index {
+	return &index{
+		keys:      make(map[cgiID]uint64),
+		neighbors: make(map[uint64][]cgiID),
+		listedBy:  make(map[cgiID]map[uint64]bool),
+	}
+}
+
+// add indexes an entry, replacing the old entry of the key if any
+func (i *index) add(key uint64, old *Entry, entry *Entry) {
+	i.remove(key, old)
+	if id, ok := newCGIID(entry.Key.CellGlobalID); ok {
+		i.keys[id] = key
+	}
+	ids := make([]cgiID, 0, len(entry.Value.Neighbors))
+	for _, n := range entry.Value.Neighbors {
+		cgi, _, _, err := parse.GetNeighborInfo(n)
+		if err != nil {
+			continue
+		}
+		id, ok := newCGIID(cgi)
+		if !ok {
+			continue
+		}
+		ids = append(ids, id)
+		if i.listedBy[id] == nil {
+			i.listedBy[id] = make(map[uint64]bool)
+		}
+		i.listedBy[id][key] = true
+	}
+	i.neighbors[key] = ids
+}
+
+// remove removes the entry of a key from the index
+func (i *index) remove(key uint64, entry *Entry) {
+	if entry != nil {
+		if id, ok := newCGIID(entry.Key.CellGlobalID); ok && i.keys[id] == key {
+			delete(i.keys, id)
+		}
+	}
+	for _, id := range i.neighbors[key] {
+		delete(i.listedBy[id], key)
+		if len(i.listedBy[id]) == 0 {
+			delete(i.listedBy, id)
+		}
+	}
+	delete(i.neighbors, key)
+}
+
+// get returns the key of a CGI
+func (i *index) get(cgi *e2smrccomm.Cgi) (uint64, bool) {
+	id, ok := newCGIID(cgi)
+	if !ok {
+		return 0, false
+	}
+	key, ok := i.keys[id]
+	return key, ok
+}
+
+// getNeighbors returns the keys of the cells in store which are neighbors of a cell in either direction:
+// listed in its neighbor list or listing it in theirs
+func (i *index) getNeighbors(key uint64, entry *Entry) []uint64 {
+	added := map[uint64]bool{key: true}
+	keys := make([]uint64, 0)
+	for _, id := range i.neighbors[key] {
+		if k, ok := i.keys[id]; ok && !added[k] {
+			added[k] = true
+			keys = append(keys, k)
+		}
+	}
+	if id, ok := newCGIID(entry.Key.CellGlobalID); ok {
+		for k := range i.listedBy[id] {
+			if !added[k] {
+				added[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Slice(keys, func(a, b int) bool {
+		return keys[a] < keys[b]
+	})
+	return keys
+}
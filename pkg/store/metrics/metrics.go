@@ -8,7 +8,9 @@ package metrics
 import (
 	"context"
 	"sync"
+	"time"
 
+	"github.com/onosproject/onos-pci/pkg/types"
 	"github.com/onosproject/onos-pci/pkg/utils/parse"
 
 	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
@@ -32,23 +34,60 @@ type Store interface {
 	// Update updates an existing entry in the store
 	Update(ctx context.Context, key uint64, entry *Entry) error
 
-	// UpdatePci only updates pci in the existing entry
-	UpdatePci(ctx context.Context, key uint64, pci int32) error
+	// UpdatePci only updates pci in the existing entry; changeID identifies the change in its control result
+	UpdatePci(ctx context.Context, key uint64, pci int32, changeID uint64) error
+
+	// SetLocked locks or unlocks the PCI of the existing entry
+	SetLocked(ctx context.Context, key uint64, locked bool) error
+
+	// SetPCIPools sets the PCI pools and the cluster of the existing entry
+	SetPCIPools(ctx context.Context, key uint64, pools []*types.PCIPool, cluster string) error
+
+	// SetControlResult records the outcome of the RC control message sent for the existing entry
+	SetControlResult(ctx context.Context, key uint64, result types.ControlResult) error
+
+	// RollbackPci sets the PCI of the existing entry back to its previous PCI after the RC control message
+	// for the change of the result finally failed, and records the result; the entry is kept if its PCI changed since
+	RollbackPci(ctx context.Context, key uint64, result types.ControlResult) error
 
 	// Delete deletes an entry based on a given key
 	Delete(ctx context.Context, key uint64) error
 
+	// ExpireEntries marks the entries not updated by an indication message since a given time as stale,
+	// or deletes them if evict is set; it returns the keys of the expired entries
+	ExpireEntries(ctx context.Context, lastUpdatedBefore time.Time, evict bool) ([]uint64, error)
+
+	// GetByCGI gets the entry of a CGI value, e.g., of a cell in the neighbor list of another cell
+	GetByCGI(ctx context.Context, cgi *e2smrccomm.Cgi) (*Entry, error)
+
+	// GetNeighbors gets the keys of the entries which are neighbors of an entry in either direction:
+	// listed in its neighbor list or listing it in theirs
+	GetNeighbors(ctx context.Context, key uint64) ([]uint64, error)
+
 	// Entries list all of the metric store entries
 	Entries(ctx context.Context, ch chan *Entry) error
 
 	// Watch measurement store changes
-	Watch(ctx context.Context, ch chan Event) error
+	Watch(ctx context.Context, ch chan Event, opts ...WatchOption) error
+
+	// WatcherStats gets the delivery statistics of the watchers, e.g., how far behind they are
+	WatcherStats(ctx context.Context) []WatcherStats
+
+	// Close releases the resources of the store, e.g., its file
+	Close() error
 }
 
 type store struct {
-	metrics  map[uint64]*Entry
-	mu       sync.RWMutex
+	// metrics are never changed once they are returned, since the readers do not hold the lock: a change replaces
+	// the entry with an updated copy
+	metrics map[uint64]*Entry
+	index   *index
+	mu      sync.RWMutex
+	// watchers get the events of the changes while the store is locked, and the changes wait for the watchers
+	// with the Block policy once it is unlocked
 	watchers *Watchers
+	// persist writes an entry, or its removal if nil, before the change is applied and sent to the watchers
+	persist func(key uint64, entry *Entry) error
 }
 
 // NewStore creates new store
@@ -56,10 +95,23 @@ func NewStore() Store {
 	watchers := NewWatchers()
 	return &store{
 		metrics:  make(map[uint64]*Entry),
+		index:    newIndex(),
 		watchers: watchers,
 	}
 }
 
+// write persists the change of an entry, so that no change is applied or seen by the watchers unless it is persisted
+func (s *store) write(key uint64, entry *Entry) error {
+	if s.persist == nil {
+		return nil
+	}
+	return s.persist(key, entry)
+}
+
+func (s *store) Close() error {
+	return nil
+}
+
 func (s *store) Entries(_ context.Context, ch chan *Entry) error {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -77,24 +129,56 @@ func (s *store) Entries(_ context.Context, ch chan *Entry) error {
 
 func (s *store) Delete(_ context.Context, key uint64) error {
 	// TODO check the key and make sure it is not empty
+	defer s.watchers.Wait()
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	v, ok := s.metrics[key]
+	if !ok {
+		return nil
+	}
+	return s.remove(key, v)
+}
+
+// remove removes the entry of a key while the store is locked
+func (s *store) remove(key uint64, v *Entry) error {
+	if err := s.write(key, nil); err != nil {
+		return err
+	}
+	s.index.remove(key, v)
 	delete(s.metrics, key)
+	s.watchers.Send(Event{
+		Key:   key,
+		Value: *v,
+		Type:  Deleted,
+	})
 	return nil
-
 }
 
 func (s *store) Put(_ context.Context, key uint64, entry Entry) (*Entry, error) {
+	defer s.watchers.Wait()
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if entry.Value.LastUpdated.IsZero() {
+		entry.Value.LastUpdated = time.Now()
+	}
+	entry.Value.Stale = false
+	entry.Value.Reloaded = false
+
 	// preserve previous values if they exist
 	v, ok := s.metrics[key]
 	if ok && v != nil {
 		entry.Value.Metric.PreviousPCI = v.Value.Metric.PreviousPCI
 		entry.Value.Metric.ResolvedConflicts = v.Value.Metric.ResolvedConflicts
+		entry.Value.Metric.ChangeID = v.Value.Metric.ChangeID
+		entry.Value.Locked = v.Value.Locked
+		entry.Value.LastControl = v.Value.LastControl
+	}
+	if err := s.write(key, &entry); err != nil {
+		return nil, err
 	}
 
+	s.index.add(key, v, &entry)
 	s.metrics[key] = &entry
 	s.watchers.Send(Event{
 		Key:   key,
@@ -114,29 +198,115 @@ func (s *store) Get(_ context.Context, key uint64) (*Entry, error) {
 	return nil, errors.New(errors.NotFound, "the measurement entry does not exist")
 }
 
-func (s *store) Watch(ctx context.Context, ch chan Event) error {
+func (s *store) ExpireEntries(_ context.Context, lastUpdatedBefore time.Time, evict bool) ([]uint64, error) {
+	defer s.watchers.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]uint64, 0)
+	for key, v := range s.metrics {
+		if !v.Value.LastUpdated.Before(lastUpdatedBefore) || (v.Value.Stale && !evict) {
+			continue
+		}
+		if evict {
+			if err := s.remove(key, v); err != nil {
+				return keys, err
+			}
+			keys = append(keys, key)
+			continue
+		}
+		updated := *v
+		updated.Value.Stale = true
+		if err := s.write(key, &updated); err != nil {
+			return keys, err
+		}
+		keys = append(keys, key)
+		s.metrics[key] = &updated
+		s.watchers.Send(Event{
+			Key:   key,
+			Value: updated,
+			Type:  Updated,
+		})
+	}
+	return keys, nil
+}
+
+func (s *store) GetByCGI(_ context.Context, cgi *e2smrccomm.Cgi) (*Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if key, ok := s.index.get(cgi); ok {
+		if v, ok := s.metrics[key]; ok {
+			return v, nil
+		}
+	}
+	return nil, errors.New(errors.NotFound, "the measurement entry does not exist")
+}
+
+func (s *store) GetNeighbors(_ context.Context, key uint64) ([]uint64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.metrics[key]
+	if !ok {
+		return nil, errors.New(errors.NotFound, "the measurement entry does not exist")
+	}
+	return s.index.getNeighbors(key, v), nil
+}
+
+func (s *store) Watch(ctx context.Context, ch chan Event, opts ...WatchOption) error {
+	options := &watchOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	// the changes are sent under the write lock, so none is missed or replayed twice
+	s.mu.RLock()
+	var initial []Event
+	if options.replay {
+		initial = make([]Event, 0, len(s.metrics))
+		for key, v := range s.metrics {
+			initial = append(initial, Event{
+				Key:   key,
+				Value: *v,
+				Type:  Created,
+			})
+		}
+	}
 	id := uuid.New()
-	err := s.watchers.AddWatcher(id, ch)
+	err := s.watchers.AddWatcher(id, ch, WatcherConfig{
+		Filter:      options.filter(),
+		QueueSize:   options.queueSize,
+		Policy:      options.policy,
+		BufferLimit: options.bufferLimit,
+	}, initial...)
+	s.mu.RUnlock()
 	if err != nil {
 		log.Error(err)
 		close(ch)
 		return err
 	}
+	// removing the watcher closes the channel
 	go func() {
 		<-ctx.Done()
 		err = s.watchers.RemoveWatcher(id)
 		if err != nil {
 			log.Error(err)
 		}
-		close(ch)
 	}()
 	return nil
 }
 
+func (s *store) WatcherStats(_ context.Context) []WatcherStats {
+	return s.watchers.Stats()
+}
+
 func (s *store) Update(_ context.Context, key uint64, entry *Entry) error {
+	defer s.watchers.Wait()
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if _, ok := s.metrics[key]; ok {
+	if v, ok := s.metrics[key]; ok {
+		if err := s.write(key, entry); err != nil {
+			return err
+		}
+		s.index.add(key, v, entry)
 		s.metrics[key] = entry
 		s.watchers.Send(Event{
 			Key:   key,
@@ -149,17 +319,26 @@ func (s *store) Update(_ context.Context, key uint64, entry *Entry) error {
 	return errors.New(errors.NotFound, "the entry does not exist")
 }
 
-func (s *store) UpdatePci(_ context.Context, key uint64, pci int32) error {
+func (s *store) UpdatePci(_ context.Context, key uint64, pci int32, changeID uint64) error {
+	defer s.watchers.Wait()
 	s.mu.Lock()
 	defer s.mu.Unlock()
-	if _, ok := s.metrics[key]; ok {
-		v := s.metrics[key]
-		v.Value.Metric.ResolvedConflicts++
-		v.Value.Metric.PreviousPCI = v.Value.Metric.PCI
-		v.Value.Metric.PCI = pci
+	if v, ok := s.metrics[key]; ok {
+		// the metric is copied, so that the events already sent keep the previous PCI
+		metric := *v.Value.Metric
+		metric.ResolvedConflicts++
+		metric.PreviousPCI = metric.PCI
+		metric.PCI = pci
+		metric.ChangeID = changeID
+		updated := *v
+		updated.Value.Metric = &metric
+		if err := s.write(key, &updated); err != nil {
+			return err
+		}
+		s.metrics[key] = &updated
 		s.watchers.Send(Event{
 			Key:   key,
-			Value: *v,
+			Value: updated,
 			Type:  UpdatedPCI,
 		})
 		return nil
@@ -167,22 +346,152 @@ func (s *store) UpdatePci(_ context.Context, key uint64, pci int32) error {
 	return errors.New(errors.NotFound, "the entry does not exist")
 }
 
-// NewKey creates a new measurements map key
+func (s *store) SetLocked(_ context.Context, key uint64, locked bool) error {
+	defer s.watchers.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.metrics[key]; ok {
+		updated := *v
+		updated.Value.Locked = locked
+		if err := s.write(key, &updated); err != nil {
+			return err
+		}
+		s.metrics[key] = &updated
+		s.watchers.Send(Event{
+			Key:   key,
+			Value: updated,
+			Type:  Updated,
+		})
+		return nil
+	}
+	return errors.New(errors.NotFound, "the entry does not exist")
+}
+
+func (s *store) SetPCIPools(_ context.Context, key uint64, pools []*types.PCIPool, cluster string) error {
+	defer s.watchers.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.metrics[key]; ok {
+		updated := *v
+		updated.Value.PCIPoolList = pools
+		updated.Value.Cluster = cluster
+		if err := s.write(key, &updated); err != nil {
+			return err
+		}
+		s.metrics[key] = &updated
+		s.watchers.Send(Event{
+			Key:   key,
+			Value: updated,
+			Type:  Updated,
+		})
+		return nil
+	}
+	return errors.New(errors.NotFound, "the entry does not exist")
+}
+
+func (s *store) SetControlResult(_ context.Context, key uint64, result types.ControlResult) error {
+	defer s.watchers.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if v, ok := s.metrics[key]; ok {
+		updated := *v
+		updated.Value.LastControl = &result
+		if err := s.write(key, &updated); err != nil {
+			return err
+		}
+		s.metrics[key] = &updated
+		s.watchers.Send(Event{
+			Key:   key,
+			Value: updated,
+			Type:  ControlResult,
+		})
+		return nil
+	}
+	return errors.New(errors.NotFound, "the entry does not exist")
+}
+
+func (s *store) RollbackPci(_ context.Context, key uint64, result types.ControlResult) error {
+	defer s.watchers.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	v, ok := s.metrics[key]
+	if !ok {
+		return errors.New(errors.NotFound, "the entry does not exist")
+	}
+	updated := *v
+	// the PCI is only rolled back if it was not changed again since the failed change
+	if v.Value.Metric.ChangeID == result.ChangeID && v.Value.Metric.PCI == result.PCI {
+		// the PCI is not updated with UpdatedPCI, so that no control message is sent for the previous PCI
+		metric := *v.Value.Metric
+		metric.PCI = metric.PreviousPCI
+		if metric.ResolvedConflicts > 0 {
+			metric.ResolvedConflicts--
+		}
+		updated.Value.Metric = &metric
+		result.RolledBack = true
+	}
+	updated.Value.LastControl = &result
+	if err := s.write(key, &updated); err != nil {
+		return err
+	}
+	s.metrics[key] = &updated
+	s.watchers.Send(Event{
+		Key:   key,
+		Value: updated,
+		Type:  ControlResult,
+	})
+	return nil
+}
+
+// eutraKeyFlag marks the keys of EUTRA cells: the PLMN ID and the 28-bit ECI would otherwise share the value of
+// the PLMN ID and the 36-bit NCI of an NR cell
+const eutraKeyFlag = uint64(1) << 63
+
+// NewKey creates a new measurements map key; it returns 0 for an invalid CGI
 func NewKey(cellGlobalID *e2smrccomm.Cgi) uint64 {
+	key, err := ParseKey(cellGlobalID)
+	if err != nil {
+		log.Error(err)
+		return 0
+	}
+	return key
+}
+
+// ParseKey creates a new measurements map key, or returns an error if the CGI is invalid
+func ParseKey(cellGlobalID *e2smrccomm.Cgi) (uint64, error) {
 	if cellGlobalID.GetNRCgi() != nil {
 		return nrcgiToInt(cellGlobalID.GetNRCgi())
+	} else if cellGlobalID.GetEUtraCgi() != nil {
+		return ecgiToInt(cellGlobalID.GetEUtraCgi())
 	}
-	// ToDo: Add here ECGI for 4G case
-	log.Errorf("4G case is not implemented yet")
-	return 0
+	return 0, errors.NewInvalid("CGI does not have EUTRA CGI or NR CGI")
 }
 
 // convert from NRCGI to uint64
-func nrcgiToInt(nrcgi *e2smrccomm.NrCgi) uint64 {
-	array := nrcgi.GetPLmnidentity().GetValue()
-	plmnid := uint32(array[0])<<0 | uint32(array[1])<<8 | uint32(array[2])<<16
-	nci := nrcgi.NRcellIdentity.Value.Value
-	return uint64(plmnid)<<36 | parse.BitStringToUint64(nci, int(nrcgi.NRcellIdentity.Value.Len))
+func nrcgiToInt(nrcgi *e2smrccomm.NrCgi) (uint64, error) {
+	plmnid, err := plmnIDToInt(nrcgi.GetPLmnidentity().GetValue())
+	if err != nil {
+		return 0, err
+	}
+	nci := nrcgi.GetNRcellIdentity().GetValue()
+	return uint64(plmnid)<<36 | parse.BitStringToUint64(nci.GetValue(), int(nci.GetLen())), nil
+}
+
+// convert from ECGI to uint64
+func ecgiToInt(ecgi *e2smrccomm.EutraCgi) (uint64, error) {
+	plmnid, err := plmnIDToInt(ecgi.GetPLmnidentity().GetValue())
+	if err != nil {
+		return 0, err
+	}
+	eci := ecgi.GetEUtracellIdentity().GetValue()
+	return eutraKeyFlag | uint64(plmnid)<<28 | parse.BitStringToUint64(eci.GetValue(), int(eci.GetLen())), nil
+}
+
+func plmnIDToInt(array []byte) (uint32, error) {
+	if len(array) != 3 {
+		return 0, errors.NewInvalid("PLMN ID should be 3 bytes long: %v", array)
+	}
+	return uint32(array[0])<<0 | uint32(array[1])<<8 | uint32(array[2])<<16, nil
 }
 
 var _ Store = &store{}
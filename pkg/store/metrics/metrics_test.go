@@ -0,0 +1,391 @@
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
+	"github.com/onosproject/onos-lib-go/api/asn1/v1/asn1"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var samplePlmnID = []byte{38, 132, 19}
+
+func TestNewKeyNRCGI(t *testing.T) {
+	cgi := &e2smrccomm.Cgi{
+		Cgi: &e2smrccomm.Cgi_NRCgi{
+			NRCgi: &e2smrccomm.NrCgi{
+				PLmnidentity: &e2smrccomm.Plmnidentity{Value: samplePlmnID},
+				NRcellIdentity: &e2smrccomm.NrcellIdentity{
+					Value: &asn1.BitString{Value: []byte{0x00, 0x00, 0x00, 0x10, 0x10}, Len: 36},
+				},
+			},
+		},
+	}
+	assert.Equal(t, uint64(0x138426)<<36|0x101, NewKey(cgi))
+}
+
+func TestNewKeyECGI(t *testing.T) {
+	cgi := &e2smrccomm.Cgi{
+		Cgi: &e2smrccomm.Cgi_EUtraCgi{
+			EUtraCgi: &e2smrccomm.EutraCgi{
+				PLmnidentity: &e2smrccomm.Plmnidentity{Value: samplePlmnID},
+				EUtracellIdentity: &e2smrccomm.EutracellIdentity{
+					Value: &asn1.BitString{Value: []byte{0x00, 0x00, 0x10, 0x10}, Len: 28},
+				},
+			},
+		},
+	}
+	assert.Equal(t, eutraKeyFlag|uint64(0x138426)<<28|0x101, NewKey(cgi))
+
+	// the NR cell whose PLMN ID and NCI have the same value as the PLMN ID and the ECI has another key
+	nrcgi := &e2smrccomm.Cgi{
+		Cgi: &e2smrccomm.Cgi_NRCgi{
+			NRCgi: &e2smrccomm.NrCgi{
+				PLmnidentity: &e2smrccomm.Plmnidentity{Value: []byte{0x84, 0x13, 0x00}},
+				NRcellIdentity: &e2smrccomm.NrcellIdentity{
+					Value: &asn1.BitString{Value: []byte{0x26, 0x00, 0x00, 0x10, 0x10}, Len: 36},
+				},
+			},
+		},
+	}
+	assert.Equal(t, uint64(0x138426)<<28|0x101, NewKey(nrcgi))
+	assert.NotEqual(t, NewKey(cgi), NewKey(nrcgi))
+}
+
+func TestNewKeyEmptyCGI(t *testing.T) {
+	assert.Equal(t, uint64(0), NewKey(&e2smrccomm.Cgi{}))
+}
+
+func TestParseKeyShortPlmnID(t *testing.T) {
+	cgi := &e2smrccomm.Cgi{
+		Cgi: &e2smrccomm.Cgi_NRCgi{
+			NRCgi: &e2smrccomm.NrCgi{
+				PLmnidentity: &e2smrccomm.Plmnidentity{Value: []byte{38, 132}},
+				NRcellIdentity: &e2smrccomm.NrcellIdentity{
+					Value: &asn1.BitString{Value: []byte{0x00, 0x00, 0x00, 0x10, 0x10}, Len: 36},
+				},
+			},
+		},
+	}
+	_, err := ParseKey(cgi)
+	assert.True(t, errors.IsInvalid(err))
+	assert.Equal(t, uint64(0), NewKey(cgi))
+}
+
+func TestPutKeepsLock(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	newEntry := func(pci int32) Entry {
+		return Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: pci}}}
+	}
+	_, err := s.Put(ctx, 1, newEntry(1))
+	assert.NoError(t, err)
+	assert.NoError(t, s.SetLocked(ctx, 1, true))
+	assert.Error(t, s.SetLocked(ctx, 2, true))
+
+	// a new indication message of the cell does not unlock it
+	_, err = s.Put(ctx, 1, newEntry(2))
+	assert.NoError(t, err)
+	entry, err := s.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.True(t, entry.Value.Locked)
+}
+
+func TestWatchCancel(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	_, err := s.Put(ctx, 1, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: 1}}})
+	assert.NoError(t, err)
+
+	ch := make(chan Event)
+	assert.NoError(t, s.Watch(ctx, ch))
+	// a short-lived watcher, e.g., of a northbound request, is cancelled without reading the pending events
+	shortCtx, cancel := context.WithCancel(ctx)
+	shortCh := make(chan Event)
+	assert.NoError(t, s.Watch(shortCtx, shortCh))
+	assert.NoError(t, s.SetControlResult(ctx, 1, types.ControlResult{PCI: 1}))
+	cancel()
+	for range shortCh {
+	}
+
+	// the other watchers keep receiving events
+	e := <-ch
+	assert.Equal(t, ControlResult, e.Type)
+	assert.NoError(t, s.UpdatePci(ctx, 1, 2, 0))
+	e = <-ch
+	assert.Equal(t, UpdatedPCI, e.Type)
+	assert.Equal(t, int32(2), e.Value.Value.Metric.PCI)
+}
+
+func newTestEntry(nci byte, neighbors ...byte) Entry {
+	items := make([]*e2smrc.NeighborCellItem, 0, len(neighbors))
+	for _, n := range neighbors {
+		items = append(items, &e2smrc.NeighborCellItem{
+			NeighborCellItem: &e2smrc.NeighborCellItem_RanTypeChoiceNr{
+				RanTypeChoiceNr: &e2smrc.NeighborCellItemChoiceNr{
+					NRCgi: newTestNRCgi(n),
+					NRPci: &e2smrccomm.NrPci{Value: 1},
+					NRFreqInfo: &e2smrccomm.NrfrequencyInfo{
+						NrArfcn: &e2smrccomm.NrArfcn{NRarfcn: 100},
+					},
+				},
+			},
+		})
+	}
+	return Entry{
+		Key: Key{CellGlobalID: &e2smrccomm.Cgi{Cgi: &e2smrccomm.Cgi_NRCgi{NRCgi: newTestNRCgi(nci)}}},
+		Value: types.CellPCI{
+			Metric:    &types.CellMetric{PCI: 1},
+			Neighbors: items,
+		},
+	}
+}
+
+func TestGetByCGI(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	entry := newTestEntry(1)
+	key := NewKey(entry.Key.CellGlobalID)
+	_, err := s.Put(ctx, key, entry)
+	assert.NoError(t, err)
+
+	// a CGI of the same value, not the same pointer
+	cgi := &e2smrccomm.Cgi{Cgi: &e2smrccomm.Cgi_NRCgi{NRCgi: newTestNRCgi(1)}}
+	found, err := s.GetByCGI(ctx, cgi)
+	assert.NoError(t, err)
+	assert.Equal(t, key, NewKey(found.Key.CellGlobalID))
+	_, err = s.GetByCGI(ctx, &e2smrccomm.Cgi{Cgi: &e2smrccomm.Cgi_NRCgi{NRCgi: newTestNRCgi(2)}})
+	assert.True(t, errors.IsNotFound(err))
+
+	assert.NoError(t, s.Delete(ctx, key))
+	_, err = s.GetByCGI(ctx, cgi)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestGetNeighbors(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	keys := make(map[byte]uint64)
+	// 1 lists 2 and 4 (not in store); 3 lists 1 only from its side
+	for nci, neighbors := range map[byte][]byte{1: {2, 4}, 2: {1}, 3: {1}} {
+		e := newTestEntry(nci, neighbors...)
+		keys[nci] = NewKey(e.Key.CellGlobalID)
+		_, err := s.Put(ctx, keys[nci], e)
+		assert.NoError(t, err)
+	}
+
+	neighbors, err := s.GetNeighbors(ctx, keys[1])
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{keys[2], keys[3]}, neighbors)
+	neighbors, err = s.GetNeighbors(ctx, keys[3])
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{keys[1]}, neighbors)
+
+	// the index follows the new neighbor list
+	e := newTestEntry(3)
+	assert.NoError(t, s.Update(ctx, keys[3], &e))
+	neighbors, err = s.GetNeighbors(ctx, keys[1])
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{keys[2]}, neighbors)
+	assert.NoError(t, s.Delete(ctx, keys[2]))
+	neighbors, err = s.GetNeighbors(ctx, keys[1])
+	assert.NoError(t, err)
+	assert.Empty(t, neighbors)
+
+	_, err = s.GetNeighbors(ctx, keys[2])
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestDeleteEvent(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewStore()
+	entry := newTestEntry(1)
+	key := NewKey(entry.Key.CellGlobalID)
+	_, err := s.Put(ctx, key, entry)
+	assert.NoError(t, err)
+
+	ch := make(chan Event)
+	assert.NoError(t, s.Watch(ctx, ch))
+	assert.NoError(t, s.Delete(ctx, key))
+	e := <-ch
+	assert.Equal(t, Deleted, e.Type)
+	assert.Equal(t, key, e.Key)
+	assert.Equal(t, int32(1), e.Value.Value.Metric.PCI)
+
+	// deleting a missing entry is not an event
+	assert.NoError(t, s.Delete(ctx, key))
+	select {
+	case e := <-ch:
+		assert.Fail(t, "unexpected event", e)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestExpireEntries(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	old := newTestEntry(1)
+	old.Value.LastUpdated = time.Now().Add(-time.Hour)
+	oldKey := NewKey(old.Key.CellGlobalID)
+	_, err := s.Put(ctx, oldKey, old)
+	assert.NoError(t, err)
+	fresh := newTestEntry(2)
+	freshKey := NewKey(fresh.Key.CellGlobalID)
+	_, err = s.Put(ctx, freshKey, fresh)
+	assert.NoError(t, err)
+
+	cutoff := time.Now().Add(-time.Minute)
+	keys, err := s.ExpireEntries(ctx, cutoff, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{oldKey}, keys)
+	entry, err := s.Get(ctx, oldKey)
+	assert.NoError(t, err)
+	assert.True(t, entry.Value.Stale)
+	// an entry is marked stale only once
+	keys, err = s.ExpireEntries(ctx, cutoff, false)
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// a new indication message makes it fresh again
+	_, err = s.Put(ctx, oldKey, newTestEntry(1))
+	assert.NoError(t, err)
+	entry, err = s.Get(ctx, oldKey)
+	assert.NoError(t, err)
+	assert.False(t, entry.Value.Stale)
+
+	keys, err = s.ExpireEntries(ctx, time.Now().Add(time.Minute), true)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{oldKey, freshKey}, keys)
+	_, err = s.Get(ctx, freshKey)
+	assert.True(t, errors.IsNotFound(err))
+	_, err = s.GetByCGI(ctx, fresh.Key.CellGlobalID)
+	assert.True(t, errors.IsNotFound(err))
+}
+
+func TestWatchReplay(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewStore()
+	keys := make(map[topoapi.ID]uint64)
+	for nci, e2NodeID := range map[byte]topoapi.ID{1: "e2:1", 2: "e2:2"} {
+		entry := newTestEntry(nci)
+		entry.Value.E2NodeID = e2NodeID
+		keys[e2NodeID] = NewKey(entry.Key.CellGlobalID)
+		_, err := s.Put(ctx, keys[e2NodeID], entry)
+		assert.NoError(t, err)
+	}
+
+	ch := make(chan Event)
+	assert.NoError(t, s.Watch(ctx, ch, WithReplay()))
+	filteredCh := make(chan Event)
+	assert.NoError(t, s.Watch(ctx, filteredCh, WithReplay(), WithEventTypes(Created, UpdatedPCI), WithE2NodeIDs("e2:2")))
+	// the changes are delivered after the current entries
+	assert.NoError(t, s.UpdatePci(ctx, keys["e2:1"], 2, 0))
+	assert.NoError(t, s.SetLocked(ctx, keys["e2:2"], true))
+	assert.NoError(t, s.UpdatePci(ctx, keys["e2:2"], 3, 0))
+
+	replayed := make([]uint64, 0)
+	for i := 0; i < 2; i++ {
+		e := <-ch
+		assert.Equal(t, Created, e.Type)
+		replayed = append(replayed, e.Key)
+	}
+	assert.ElementsMatch(t, []uint64{keys["e2:1"], keys["e2:2"]}, replayed)
+	live := make([]MetricEvent, 0)
+	for i := 0; i < 3; i++ {
+		live = append(live, (<-ch).Type.(MetricEvent))
+	}
+	assert.ElementsMatch(t, []MetricEvent{UpdatedPCI, Updated, UpdatedPCI}, live)
+
+	e := <-filteredCh
+	assert.Equal(t, Created, e.Type)
+	assert.Equal(t, keys["e2:2"], e.Key)
+	e = <-filteredCh
+	assert.Equal(t, UpdatedPCI, e.Type)
+	assert.Equal(t, keys["e2:2"], e.Key)
+	assert.Equal(t, int32(3), e.Value.Value.Metric.PCI)
+	select {
+	case e := <-filteredCh:
+		assert.Fail(t, "unexpected event", e)
+	case <-time.After(10 * time.Millisecond):
+	}
+}
+
+func TestRollbackPci(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := NewStore()
+	entry := newTestEntry(1)
+	key := NewKey(entry.Key.CellGlobalID)
+	_, err := s.Put(ctx, key, entry)
+	assert.NoError(t, err)
+	assert.NoError(t, s.UpdatePci(ctx, key, 2, 0))
+
+	ch := make(chan Event)
+	assert.NoError(t, s.Watch(ctx, ch))
+	assert.NoError(t, s.RollbackPci(ctx, key, types.ControlResult{PCI: 2, Error: "rejected", Failure: types.RICControlFailure}))
+	// the rollback is not a PCI update, which would send a control message
+	e := <-ch
+	assert.Equal(t, ControlResult, e.Type)
+	assert.Equal(t, int32(1), e.Value.Value.Metric.PCI)
+	assert.Equal(t, uint32(0), e.Value.Value.Metric.ResolvedConflicts)
+	assert.True(t, e.Value.Value.LastControl.RolledBack)
+
+	// a failure for a PCI which is no longer the cell's is only recorded
+	assert.NoError(t, s.UpdatePci(ctx, key, 3, 0))
+	<-ch
+	assert.NoError(t, s.RollbackPci(ctx, key, types.ControlResult{PCI: 2, Error: "rejected"}))
+	e = <-ch
+	assert.Equal(t, int32(3), e.Value.Value.Metric.PCI)
+	assert.False(t, e.Value.Value.LastControl.RolledBack)
+
+	// nor is a failure of an earlier change to the same PCI
+	assert.NoError(t, s.UpdatePci(ctx, key, 2, 1))
+	<-ch
+	assert.NoError(t, s.UpdatePci(ctx, key, 3, 2))
+	<-ch
+	assert.NoError(t, s.UpdatePci(ctx, key, 2, 3))
+	<-ch
+	assert.NoError(t, s.RollbackPci(ctx, key, types.ControlResult{PCI: 2, ChangeID: 1, Error: "rejected"}))
+	e = <-ch
+	assert.Equal(t, int32(2), e.Value.Value.Metric.PCI)
+	assert.False(t, e.Value.Value.LastControl.RolledBack)
+	assert.True(t, errors.IsNotFound(s.RollbackPci(ctx, 2, types.ControlResult{})))
+}
+
+func TestSetPCIPools(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	entry := newTestEntry(1)
+	key := NewKey(entry.Key.CellGlobalID)
+	_, err := s.Put(ctx, key, entry)
+	assert.NoError(t, err)
+	stale, err := s.Get(ctx, key)
+	assert.NoError(t, err)
+
+	// the changes made since the entry was read are kept
+	assert.NoError(t, s.UpdatePci(ctx, key, 2, 1))
+	assert.NoError(t, s.SetLocked(ctx, key, true))
+	pools := []*types.PCIPool{{LowerPci: 1, UpperPci: 20}}
+	assert.NoError(t, s.SetPCIPools(ctx, key, pools, "cluster"))
+	updated, err := s.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, pools, updated.Value.PCIPoolList)
+	assert.Equal(t, "cluster", updated.Value.Cluster)
+	assert.Equal(t, int32(2), updated.Value.Metric.PCI)
+	assert.True(t, updated.Value.Locked)
+	assert.Equal(t, int32(1), stale.Value.Metric.PCI)
+	assert.Empty(t, stale.Value.Cluster)
+	assert.True(t, errors.IsNotFound(s.SetPCIPools(ctx, 2, pools, "")))
+}
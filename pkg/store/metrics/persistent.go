@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"time"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/types"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+var metricsBucket = []byte("metrics")
+
+// DefaultReloadTTL is the default time within which the cells reloaded from the file have to send an indication
+// message, after which they are removed
+const DefaultReloadTTL = 10 * time.Minute
+
+// record is the on-disk form of an entry; the CGI and the neighbors are protobuf messages with oneof fields,
+// so they are stored in protobuf encoding. The time of the last indication message is not stored, so that the
+// periodic indication messages which change nothing else are not written.
+type record struct {
+	CGI         []byte
+	E2NodeID    topoapi.ID
+	Cluster     string
+	Locked      bool
+	Metric      *types.CellMetric
+	PCIPoolList []*types.PCIPool
+	Neighbors   [][]byte
+	LastControl *types.ControlResult
+	Stale       bool
+}
+
+// persistentStore is the in-memory store whose entries are also written to a BoltDB file on each change,
+// so that PreviousPCI, ResolvedConflicts, locks and control outcomes survive restarts; the file is written
+// before the in-memory entry is changed, so a change that could not be written is neither applied nor sent
+type persistentStore struct {
+	*store
+	db *bolt.DB
+	// reloadExpiry removes the reloaded cells which did not send an indication message within the reload TTL
+	reloadExpiry *time.Timer
+}
+
+// NewPersistentStore creates new store persisted in the BoltDB file at a given path, loading the entries in the file;
+// the reloaded cells which do not send an indication message within the reload TTL, DefaultReloadTTL if not positive,
+// are removed, e.g., the cells removed while onos-pci was down
+func NewPersistentStore(path string, reloadTTL time.Duration) (Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+	if err != nil {
+		return nil, errors.NewUnavailable("could not open metrics store file %v: %v", path, err)
+	}
+	s := &persistentStore{
+		store: NewStore().(*store),
+		db:    db,
+	}
+	if err := s.load(); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	s.store.persist = s.write
+	if reloadTTL <= 0 {
+		reloadTTL = DefaultReloadTTL
+	}
+	s.reloadExpiry = time.AfterFunc(reloadTTL, s.expireReloaded)
+	return s, nil
+}
+
+// load reads all entries in the file into the in-memory store, marked as reloaded; the cells get the load time as
+// their last update, so that the cells which were fresh before a restart get a full TTL to report again rather than
+// all going stale at once
+func (s *persistentStore) load() error {
+	now := time.Now()
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(metricsBucket)
+		if err != nil {
+			return err
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			entry, err := decodeEntry(v)
+			if err != nil {
+				log.Warnf("Skipping corrupted metrics store entry %v: %v", binary.BigEndian.Uint64(k), err)
+				return nil
+			}
+			key := binary.BigEndian.Uint64(k)
+			entry.Value.LastUpdated = now
+			entry.Value.Reloaded = true
+			s.store.index.add(key, nil, entry)
+			s.store.metrics[key] = entry
+			return nil
+		})
+	})
+	if err != nil {
+		return errors.NewUnavailable("could not load metrics store: %v", err)
+	}
+	log.Infof("Loaded %v entries from metrics store", len(s.store.metrics))
+	return nil
+}
+
+// expireReloaded removes the reloaded cells which did not send an indication message since
+func (s *persistentStore) expireReloaded() {
+	defer s.watchers.Wait()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]uint64, 0)
+	for key, v := range s.metrics {
+		if !v.Value.Reloaded {
+			continue
+		}
+		if err := s.remove(key, v); err != nil {
+			log.Warn(err)
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) > 0 {
+		log.Infof("Cells %v reloaded from metrics store did not send indication messages since: removed", keys)
+	}
+}
+
+// write writes the entry of a key to the file, or deletes the key if the entry is nil; an entry which is already
+// in the file as it is, e.g., after an indication message which changed nothing else than its time, is not written
+// again, so that the file is only synced for the actual changes
+func (s *persistentStore) write(key uint64, entry *Entry) error {
+	var value []byte
+	if entry != nil {
+		var err error
+		if value, err = encodeEntry(entry); err != nil {
+			return errors.NewInvalid("could not encode metrics store entry %v: %v", key, err)
+		}
+		var unchanged bool
+		_ = s.db.View(func(tx *bolt.Tx) error {
+			unchanged = bytes.Equal(tx.Bucket(metricsBucket).Get(encodeKey(key)), value)
+			return nil
+		})
+		if unchanged {
+			return nil
+		}
+	}
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		if entry == nil {
+			return tx.Bucket(metricsBucket).Delete(encodeKey(key))
+		}
+		return tx.Bucket(metricsBucket).Put(encodeKey(key), value)
+	})
+	if err != nil {
+		return errors.NewUnavailable("could not write metrics store entry %v: %v", key, err)
+	}
+	return nil
+}
+
+// Close closes the file; the store cannot be changed after it is closed
+func (s *persistentStore) Close() error {
+	s.reloadExpiry.Stop()
+	return s.db.Close()
+}
+
+func encodeKey(key uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, key)
+	return k
+}
+
+func encodeEntry(entry *Entry) ([]byte, error) {
+	r := record{
+		E2NodeID:    entry.Value.E2NodeID,
+		Cluster:     entry.Value.Cluster,
+		Locked:      entry.Value.Locked,
+		Metric:      entry.Value.Metric,
+		PCIPoolList: entry.Value.PCIPoolList,
+		Neighbors:   make([][]byte, 0, len(entry.Value.Neighbors)),
+		LastControl: entry.Value.LastControl,
+		Stale:       entry.Value.Stale,
+	}
+	// the encoding is deterministic, so that an unchanged entry is encoded the same
+	marshal := proto.MarshalOptions{Deterministic: true}
+	var err error
+	if entry.Key.CellGlobalID != nil {
+		if r.CGI, err = marshal.Marshal(entry.Key.CellGlobalID); err != nil {
+			return nil, err
+		}
+	}
+	for _, n := range entry.Value.Neighbors {
+		neighbor, err := marshal.Marshal(n)
+		if err != nil {
+			return nil, err
+		}
+		r.Neighbors = append(r.Neighbors, neighbor)
+	}
+	return json.Marshal(r)
+}
+
+func decodeEntry(value []byte) (*Entry, error) {
+	var r record
+	if err := json.Unmarshal(value, &r); err != nil {
+		return nil, err
+	}
+	if r.Metric == nil {
+		return nil, errors.NewInvalid("entry has no metric")
+	}
+	entry := &Entry{
+		Value: types.CellPCI{
+			E2NodeID:    r.E2NodeID,
+			Cluster:     r.Cluster,
+			Locked:      r.Locked,
+			Metric:      r.Metric,
+			PCIPoolList: r.PCIPoolList,
+			Neighbors:   make([]*e2smrc.NeighborCellItem, 0, len(r.Neighbors)),
+			LastControl: r.LastControl,
+			Stale:       r.Stale,
+		},
+	}
+	if r.CGI != nil {
+		cgi := &e2smrccomm.Cgi{}
+		if err := proto.Unmarshal(r.CGI, cgi); err != nil {
+			return nil, err
+		}
+		entry.Key.CellGlobalID = cgi
+	}
+	for _, n := range r.Neighbors {
+		neighbor := &e2smrc.NeighborCellItem{}
+		if err := proto.Unmarshal(n, neighbor); err != nil {
+			return nil, err
+		}
+		entry.Value.Neighbors = append(entry.Value.Neighbors, neighbor)
+	}
+	return entry, nil
+}
+
+var _ Store = &persistentStore{}
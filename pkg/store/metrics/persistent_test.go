@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
+	"github.com/onosproject/onos-lib-go/api/asn1/v1/asn1"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+func newTestNRCgi(nci byte) *e2smrccomm.NrCgi {
+	return &e2smrccomm.NrCgi{
+		PLmnidentity: &e2smrccomm.Plmnidentity{Value: samplePlmnID},
+		NRcellIdentity: &e2smrccomm.NrcellIdentity{
+			Value: &asn1.BitString{Value: []byte{0x00, 0x00, 0x00, 0x00, nci << 4}, Len: 36},
+		},
+	}
+}
+
+func TestPersistentStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "metrics.db")
+	s, err := NewPersistentStore(path, 0)
+	assert.NoError(t, err)
+
+	cgi := &e2smrccomm.Cgi{Cgi: &e2smrccomm.Cgi_NRCgi{NRCgi: newTestNRCgi(1)}}
+	neighbor := &e2smrc.NeighborCellItem{
+		NeighborCellItem: &e2smrc.NeighborCellItem_RanTypeChoiceNr{
+			RanTypeChoiceNr: &e2smrc.NeighborCellItemChoiceNr{
+				NRCgi: newTestNRCgi(2),
+				NRPci: &e2smrccomm.NrPci{Value: 2},
+				NRFreqInfo: &e2smrccomm.NrfrequencyInfo{
+					NrArfcn: &e2smrccomm.NrArfcn{NRarfcn: 100},
+				},
+			},
+		},
+	}
+	key := NewKey(cgi)
+	_, err = s.Put(ctx, key, Entry{
+		Key: Key{CellGlobalID: cgi},
+		Value: types.CellPCI{
+			E2NodeID:    "e2:1",
+			Cluster:     "downtown",
+			Metric:      &types.CellMetric{ARFCN: 100, PCI: 1},
+			PCIPoolList: []*types.PCIPool{{LowerPci: 1, UpperPci: 10}},
+			Neighbors:   []*e2smrc.NeighborCellItem{neighbor},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, s.UpdatePci(ctx, key, 3, 0))
+	assert.NoError(t, s.SetLocked(ctx, key, true))
+	assert.NoError(t, s.SetControlResult(ctx, key, types.ControlResult{PCI: 3, Outcome: []byte{1}}))
+	_, err = s.Put(ctx, 2, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: 2}}})
+	assert.NoError(t, err)
+	assert.NoError(t, s.Delete(ctx, 2))
+	_, err = s.Put(ctx, 3, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: 4}, LastUpdated: time.Now().Add(-time.Hour)}})
+	assert.NoError(t, err)
+	assert.NoError(t, s.Close())
+
+	// the state is reloaded after a restart, and the TTL of the cells starts again
+	loaded := time.Now()
+	s, err = NewPersistentStore(path, 0)
+	assert.NoError(t, err)
+	defer s.Close()
+	entry, err := s.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.True(t, entry.Value.Reloaded)
+	assert.True(t, proto.Equal(cgi, entry.Key.CellGlobalID))
+	assert.Equal(t, key, NewKey(entry.Key.CellGlobalID))
+	assert.Equal(t, types.CellMetric{ARFCN: 100, PCI: 3, PreviousPCI: 1, ResolvedConflicts: 1}, *entry.Value.Metric)
+	assert.True(t, entry.Value.Locked)
+	assert.Equal(t, "downtown", entry.Value.Cluster)
+	assert.EqualValues(t, "e2:1", entry.Value.E2NodeID)
+	assert.Equal(t, []*types.PCIPool{{LowerPci: 1, UpperPci: 10}}, entry.Value.PCIPoolList)
+	assert.Len(t, entry.Value.Neighbors, 1)
+	assert.True(t, proto.Equal(neighbor, entry.Value.Neighbors[0]))
+	assert.Equal(t, &types.ControlResult{PCI: 3, Outcome: []byte{1}}, entry.Value.LastControl)
+	_, err = s.Get(ctx, 2)
+	assert.Error(t, err)
+	entry, err = s.Get(ctx, 3)
+	assert.NoError(t, err)
+	assert.False(t, entry.Value.LastUpdated.Before(loaded))
+	keys, err := s.ExpireEntries(ctx, loaded, false)
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	// a new indication message keeps the persisted values
+	_, err = s.Put(ctx, key, Entry{
+		Key:   Key{CellGlobalID: cgi},
+		Value: types.CellPCI{Metric: &types.CellMetric{ARFCN: 100, PCI: 3}},
+	})
+	assert.NoError(t, err)
+	entry, err = s.Get(ctx, key)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), entry.Value.Metric.PreviousPCI)
+	assert.True(t, entry.Value.Locked)
+	assert.False(t, entry.Value.Reloaded)
+}
+
+func TestPersistentStoreReloadTTL(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "metrics.db")
+	s, err := NewPersistentStore(path, 0)
+	assert.NoError(t, err)
+	for _, key := range []uint64{1, 2} {
+		_, err = s.Put(ctx, key, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: int32(key)}}})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, s.Close())
+
+	// the reloaded cell which does not send an indication message within the reload TTL is removed
+	s, err = NewPersistentStore(path, 100*time.Millisecond)
+	assert.NoError(t, err)
+	defer s.Close()
+	ch := make(chan Event, 10)
+	assert.NoError(t, s.Watch(ctx, ch, WithEventTypes(Deleted)))
+	_, err = s.Put(ctx, 2, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: 2}}})
+	assert.NoError(t, err)
+	event := <-ch
+	assert.Equal(t, uint64(1), event.Key)
+	_, err = s.Get(ctx, 1)
+	assert.Error(t, err)
+	_, err = s.Get(ctx, 2)
+	assert.NoError(t, err)
+}
+
+func TestPersistentStoreWriteFailure(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewPersistentStore(filepath.Join(t.TempDir(), "metrics.db"), 0)
+	assert.NoError(t, err)
+	_, err = s.Put(ctx, 1, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: 1}}})
+	assert.NoError(t, err)
+	ch := make(chan Event, 10)
+	assert.NoError(t, s.Watch(ctx, ch, WithEventTypes(UpdatedPCI, Updated, Deleted)))
+
+	// the changes which could not be written are neither applied nor sent
+	assert.NoError(t, s.Close())
+	assert.Error(t, s.UpdatePci(ctx, 1, 2, 0))
+	assert.Error(t, s.SetLocked(ctx, 1, true))
+	assert.Error(t, s.Delete(ctx, 1))
+	entry, err := s.Get(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), entry.Value.Metric.PCI)
+	assert.False(t, entry.Value.Locked)
+	assert.Len(t, ch, 0)
+}
+
+func TestPersistentStoreUnchangedWrite(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewPersistentStore(filepath.Join(t.TempDir(), "metrics.db"), 0)
+	assert.NoError(t, err)
+	defer s.Close()
+	db := s.(*persistentStore).db
+	_, err = s.Put(ctx, 1, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: 1}}})
+	assert.NoError(t, err)
+	writes := db.Stats().TxStats.Write
+
+	// an indication message which changes nothing else than its time is not written
+	_, err = s.Put(ctx, 1, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: 1}}})
+	assert.NoError(t, err)
+	assert.Equal(t, writes, db.Stats().TxStats.Write)
+	_, err = s.Put(ctx, 1, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: 2}}})
+	assert.NoError(t, err)
+	assert.Greater(t, db.Stats().TxStats.Write, writes)
+}
@@ -35,8 +35,10 @@ const (
 	UpdatedPCI
 	// Deleted deleted measurement event
 	Deleted
+	// ControlResult RC control outcome of the PCI update event
+	ControlResult
 )
 
 func (e MetricEvent) String() string {
-	return [...]string{"None", "Created", "Updated", "UpdatedPCI", "Deleted"}[e]
+	return [...]string{"None", "Created", "Updated", "UpdatedPCI", "Deleted", "ControlResult"}[e]
 }
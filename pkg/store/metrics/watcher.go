@@ -6,68 +6,310 @@ package metrics
 
 import (
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
 )
 
+// DefaultQueueSize is the default number of events queued for a watcher
+const DefaultQueueSize = 1000
+
+// OverflowPolicy is what happens to a new event when the queue of a watcher is full
+type OverflowPolicy int
+
+const (
+	// Block queues the event, but the store change returns only once the watcher has read the events beyond
+	// the queue size; it waits after releasing the store, so that the store can still be read meanwhile
+	Block OverflowPolicy = iota
+	// DropOldest drops the oldest queued event
+	DropOldest
+	// Disconnect removes the watcher and closes its channel
+	Disconnect
+	// Buffer queues the event beyond the queue size, so that no store change waits, up to the buffer limit
+	// beyond which the watcher is disconnected
+	Buffer
+)
+
+func (p OverflowPolicy) String() string {
+	return [...]string{"Block", "DropOldest", "Disconnect", "Buffer"}[p]
+}
+
 // EventChannel is a channel which can accept an Event
 type EventChannel chan Event
 
+// WatcherConfig is the configuration of a watcher
+type WatcherConfig struct {
+	// Filter accepts the events sent to the watcher; nil accepts all of them
+	Filter func(Event) bool
+	// QueueSize is the maximum number of queued events; 0 is DefaultQueueSize
+	QueueSize int
+	// Policy is what happens when the queue is full
+	Policy OverflowPolicy
+	// BufferLimit is the maximum number of events queued with the Buffer policy; it is required with it
+	BufferLimit int
+}
+
+// WatcherStats are the delivery statistics of a watcher
+type WatcherStats struct {
+	ID uuid.UUID
+	// Queued is the number of events not read yet by the watcher
+	Queued int
+	// MaxQueued is the highest number of queued events so far
+	MaxQueued int
+	// Lag is the time the oldest queued event has been waiting
+	Lag       time.Duration
+	Delivered uint64
+	Dropped   uint64
+}
+
 // Watchers stores the information about watchers
 type Watchers struct {
-	watchers map[uuid.UUID]Watcher
+	watchers map[uuid.UUID]*Watcher
 	rm       sync.RWMutex
 }
 
-// Watcher event watcher
+type queuedEvent struct {
+	event    Event
+	queuedAt time.Time
+}
+
+// Watcher event watcher; a single goroutine sends its queued events in order
 type Watcher struct {
-	id uuid.UUID
-	ch chan<- Event
+	id     uuid.UUID
+	ch     chan<- Event
+	config WatcherConfig
+
+	mu        sync.Mutex
+	queue     []queuedEvent
+	maxQueued int
+	delivered uint64
+	dropped   uint64
+
+	// notify signals a queued event to the sending goroutine
+	notify chan struct{}
+	// space is closed, and replaced, once the queue is within its size again, to release the waiting store changes
+	space chan struct{}
+	// done is closed when the watcher is removed, to stop the sending goroutine
+	done     chan struct{}
+	stopOnce sync.Once
+	// stopped is closed once the sending goroutine has closed the channel
+	stopped chan struct{}
 }
 
 // NewWatchers creates watchers
 func NewWatchers() *Watchers {
 	return &Watchers{
-		watchers: make(map[uuid.UUID]Watcher),
+		watchers: make(map[uuid.UUID]*Watcher),
 	}
 }
 
-// Send sends an event for all registered watchers
+// Send queues an event for all registered watchers; it never blocks, so that it can be called while the store
+// is locked to keep the events in the order of the changes
 func (ws *Watchers) Send(event Event) {
 	ws.rm.RLock()
-	go func() {
-		for _, watcher := range ws.watchers {
-			watcher.ch <- event
+	watchers := make([]*Watcher, 0, len(ws.watchers))
+	for _, watcher := range ws.watchers {
+		watchers = append(watchers, watcher)
+	}
+	ws.rm.RUnlock()
+
+	for _, watcher := range watchers {
+		if watcher.config.Filter != nil && !watcher.config.Filter(event) {
+			continue
+		}
+		if !watcher.enqueue(event) {
+			log.Warnf("Watcher %v is too slow: disconnecting it", watcher.id)
+			_ = ws.RemoveWatcher(watcher.id)
+		}
+	}
+}
+
+// Wait waits until the watchers with the Block policy have read the events queued beyond their queue size;
+// the store changes wait after releasing the store
+func (ws *Watchers) Wait() {
+	ws.rm.RLock()
+	watchers := make([]*Watcher, 0)
+	for _, watcher := range ws.watchers {
+		if watcher.config.Policy == Block {
+			watchers = append(watchers, watcher)
 		}
-	}()
+	}
 	ws.rm.RUnlock()
+
+	for _, watcher := range watchers {
+		watcher.waitForSpace()
+	}
 }
 
-// AddWatcher adds a watcher
-func (ws *Watchers) AddWatcher(id uuid.UUID, ch chan<- Event) error {
-	ws.rm.Lock()
-	watcher := Watcher{
-		id: id,
-		ch: ch,
+// AddWatcher adds a watcher; the accepted initial events are queued before any later event, regardless of
+// the queue size. The channel is closed once the watcher is removed.
+func (ws *Watchers) AddWatcher(id uuid.UUID, ch chan<- Event, config WatcherConfig, initial ...Event) error {
+	if config.QueueSize <= 0 {
+		config.QueueSize = DefaultQueueSize
+	}
+	if config.Policy == Buffer && config.BufferLimit < config.QueueSize {
+		return errors.NewInvalid("buffer limit %v of watcher %v is below its queue size %v", config.BufferLimit, id, config.QueueSize)
+	}
+	watcher := &Watcher{
+		id:      id,
+		ch:      ch,
+		config:  config,
+		queue:   make([]queuedEvent, 0, len(initial)),
+		notify:  make(chan struct{}, 1),
+		space:   make(chan struct{}),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	now := time.Now()
+	for _, event := range initial {
+		if config.Filter == nil || config.Filter(event) {
+			watcher.queue = append(watcher.queue, queuedEvent{event: event, queuedAt: now})
+		}
 	}
+	watcher.maxQueued = len(watcher.queue)
+	go watcher.run()
+
+	ws.rm.Lock()
 	ws.watchers[id] = watcher
 	ws.rm.Unlock()
+	watcher.signal(watcher.notify)
 	return nil
 
 }
 
-// RemoveWatcher removes a watcher
+// RemoveWatcher removes a watcher; once it returns, no more events are sent to the watcher's channel
+// and the channel is closed
 func (ws *Watchers) RemoveWatcher(id uuid.UUID) error {
 	ws.rm.Lock()
-	watchers := make(map[uuid.UUID]Watcher, len(ws.watchers)-1)
+	watcher, ok := ws.watchers[id]
+	delete(ws.watchers, id)
+	ws.rm.Unlock()
+	if ok {
+		watcher.stop()
+		<-watcher.stopped
+	}
+	return nil
+
+}
+
+// Stats gets the delivery statistics of the watchers
+func (ws *Watchers) Stats() []WatcherStats {
+	ws.rm.RLock()
+	defer ws.rm.RUnlock()
+	stats := make([]WatcherStats, 0, len(ws.watchers))
 	for _, watcher := range ws.watchers {
-		if watcher.id != id {
-			watchers[id] = watcher
+		stats = append(stats, watcher.stats())
+	}
+	return stats
+}
 
+// enqueue queues an event following the overflow policy; it returns false if the watcher must be disconnected
+func (w *Watcher) enqueue(event Event) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.queue) >= w.config.QueueSize {
+		switch w.config.Policy {
+		case DropOldest:
+			w.queue = w.queue[1:]
+			w.dropped++
+			if w.dropped == 1 || w.dropped%uint64(w.config.QueueSize) == 0 {
+				log.Warnf("Watcher %v is too slow: %d events dropped", w.id, w.dropped)
+			}
+		case Disconnect:
+			return false
+		case Buffer:
+			if len(w.queue) >= w.config.BufferLimit {
+				return false
+			}
+			if len(w.queue)%w.config.QueueSize == 0 {
+				log.Warnf("Watcher %v is too slow: %d events queued", w.id, len(w.queue))
+			}
 		}
 	}
-	ws.watchers = watchers
-	ws.rm.Unlock()
-	return nil
+	w.queue = append(w.queue, queuedEvent{event: event, queuedAt: time.Now()})
+	if len(w.queue) > w.maxQueued {
+		w.maxQueued = len(w.queue)
+	}
+	w.signal(w.notify)
+	return true
+}
 
+// waitForSpace waits until the queue is within its size, or the watcher is removed
+func (w *Watcher) waitForSpace() {
+	w.mu.Lock()
+	full := len(w.queue) > w.config.QueueSize
+	space := w.space
+	w.mu.Unlock()
+	if !full {
+		return
+	}
+	select {
+	case <-space:
+	case <-w.done:
+	}
+}
+
+// run sends the queued events in order until the watcher is stopped, then closes the channel
+func (w *Watcher) run() {
+	defer close(w.stopped)
+	defer close(w.ch)
+	for {
+		w.mu.Lock()
+		if len(w.queue) == 0 {
+			w.mu.Unlock()
+			select {
+			case <-w.notify:
+				continue
+			case <-w.done:
+				return
+			}
+		}
+		next := w.queue[0]
+		w.queue = w.queue[1:]
+		if len(w.queue) == w.config.QueueSize {
+			close(w.space)
+			w.space = make(chan struct{})
+		}
+		w.mu.Unlock()
+
+		select {
+		case w.ch <- next.event:
+			w.mu.Lock()
+			w.delivered++
+			w.mu.Unlock()
+		case <-w.done:
+			return
+		}
+	}
+}
+
+func (w *Watcher) stop() {
+	w.stopOnce.Do(func() {
+		close(w.done)
+	})
+}
+
+// signal wakes up the goroutine waiting on a channel, if any
+func (w *Watcher) signal(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+func (w *Watcher) stats() WatcherStats {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	stats := WatcherStats{
+		ID:        w.id,
+		Queued:    len(w.queue),
+		MaxQueued: w.maxQueued,
+		Delivered: w.delivered,
+		Dropped:   w.dropped,
+	}
+	if len(w.queue) > 0 {
+		stats.Lag = time.Since(w.queue[0].queuedAt)
+	}
+	return stats
 }
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestWatchStore(t *testing.T) Store {
+	s := NewStore()
+	_, err := s.Put(context.Background(), 1, Entry{Value: types.CellPCI{Metric: &types.CellMetric{PCI: 0}}})
+	assert.NoError(t, err)
+	return s
+}
+
+// readPcis reads the PCIs of the events until none arrives for a while
+func readPcis(ch chan Event) []int32 {
+	pcis := make([]int32, 0)
+	for {
+		select {
+		case e, ok := <-ch:
+			if !ok {
+				return pcis
+			}
+			pcis = append(pcis, e.Value.Value.Metric.PCI)
+		case <-time.After(50 * time.Millisecond):
+			return pcis
+		}
+	}
+}
+
+func TestWatchDropOldest(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := newTestWatchStore(t)
+	ch := make(chan Event)
+	assert.NoError(t, s.Watch(ctx, ch, WithQueueSize(2), WithOverflowPolicy(DropOldest)))
+	for pci := int32(1); pci <= 10; pci++ {
+		assert.NoError(t, s.UpdatePci(ctx, 1, pci, 0))
+	}
+
+	stats := s.WatcherStats(ctx)
+	assert.Len(t, stats, 1)
+	assert.Equal(t, 2, stats[0].Queued)
+	assert.Equal(t, 2, stats[0].MaxQueued)
+	assert.True(t, stats[0].Dropped >= 7)
+	assert.True(t, stats[0].Lag > 0)
+
+	// the newest events are delivered in order
+	pcis := readPcis(ch)
+	assert.Equal(t, []int32{9, 10}, pcis[len(pcis)-2:])
+	assert.IsIncreasing(t, pcis)
+	stats = s.WatcherStats(ctx)
+	assert.Equal(t, 0, stats[0].Queued)
+	assert.Equal(t, uint64(10), stats[0].Delivered+stats[0].Dropped)
+}
+
+func TestWatchDisconnect(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := newTestWatchStore(t)
+	ch := make(chan Event)
+	assert.NoError(t, s.Watch(ctx, ch, WithQueueSize(2), WithOverflowPolicy(Disconnect)))
+	for pci := int32(1); pci <= 10; pci++ {
+		assert.NoError(t, s.UpdatePci(ctx, 1, pci, 0))
+	}
+
+	// the channel is closed after the events queued before the overflow
+	pcis := readPcis(ch)
+	assert.True(t, len(pcis) <= 3)
+	_, ok := <-ch
+	assert.False(t, ok)
+	assert.Empty(t, s.WatcherStats(ctx))
+}
+
+func TestWatchBlock(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := newTestWatchStore(t)
+	ch := make(chan Event)
+	// the watchers block the changes by default
+	assert.NoError(t, s.Watch(ctx, ch, WithQueueSize(1)))
+	updated := make(chan struct{})
+	go func() {
+		defer close(updated)
+		for pci := int32(1); pci <= 10; pci++ {
+			assert.NoError(t, s.UpdatePci(ctx, 1, pci, 0))
+		}
+	}()
+
+	select {
+	case <-updated:
+		assert.Fail(t, "the store changes should wait for the watcher")
+	case <-time.After(20 * time.Millisecond):
+	}
+	// the store is not locked while the changes wait
+	_, err := s.Get(ctx, 1)
+	assert.NoError(t, err)
+	// no event is lost
+	assert.Equal(t, []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, readPcis(ch))
+	<-updated
+
+	// a removed watcher does not block the changes
+	cancel()
+	readPcis(ch)
+	assert.NoError(t, s.UpdatePci(context.Background(), 1, 11, 0))
+}
+
+func TestWatchBuffer(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := newTestWatchStore(t)
+	// the buffer has to be limited
+	err := s.Watch(ctx, make(chan Event), WithOverflowPolicy(Buffer))
+	assert.True(t, errors.IsInvalid(err))
+	ch := make(chan Event)
+	assert.NoError(t, s.Watch(ctx, ch, WithQueueSize(1), WithOverflowPolicy(Buffer), WithBufferLimit(10),
+		WithEventTypes(UpdatedPCI)))
+
+	// a watcher changing the store while reading its events does not block the changes
+	read := make(chan []int32)
+	go func() {
+		pcis := make([]int32, 0)
+		for e := range ch {
+			assert.NoError(t, s.SetLocked(ctx, 1, true))
+			pcis = append(pcis, e.Value.Value.Metric.PCI)
+			if len(pcis) == 10 {
+				break
+			}
+		}
+		read <- pcis
+	}()
+	for pci := int32(1); pci <= 10; pci++ {
+		assert.NoError(t, s.UpdatePci(ctx, 1, pci, 0))
+	}
+	stats := s.WatcherStats(ctx)
+	assert.Equal(t, uint64(0), stats[0].Dropped)
+
+	// no event is lost
+	assert.Equal(t, []int32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, <-read)
+
+	// the watcher is disconnected beyond the buffer limit
+	for pci := int32(11); pci <= 30; pci++ {
+		assert.NoError(t, s.UpdatePci(ctx, 1, pci, 0))
+	}
+	assert.Empty(t, s.WatcherStats(ctx))
+	for range ch {
+	}
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics
+
+import (
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+)
+
+// WatchOption is an option of a store watch
+type WatchOption func(*watchOptions)
+
+type watchOptions struct {
+	replay      bool
+	eventTypes  map[MetricEvent]bool
+	e2NodeIDs   map[topoapi.ID]bool
+	queueSize   int
+	policy      OverflowPolicy
+	bufferLimit int
+}
+
+// WithReplay replays the existing entries as Created events before the changes
+func WithReplay() WatchOption {
+	return func(options *watchOptions) {
+		options.replay = true
+	}
+}
+
+// WithEventTypes only watches the events of the given types
+func WithEventTypes(eventTypes ...MetricEvent) WatchOption {
+	return func(options *watchOptions) {
+		if options.eventTypes == nil {
+			options.eventTypes = make(map[MetricEvent]bool)
+		}
+		for _, eventType := range eventTypes {
+			options.eventTypes[eventType] = true
+		}
+	}
+}
+
+// WithE2NodeIDs only watches the events of the cells of the given E2 nodes
+func WithE2NodeIDs(e2NodeIDs ...topoapi.ID) WatchOption {
+	return func(options *watchOptions) {
+		if options.e2NodeIDs == nil {
+			options.e2NodeIDs = make(map[topoapi.ID]bool)
+		}
+		for _, e2NodeID := range e2NodeIDs {
+			options.e2NodeIDs[e2NodeID] = true
+		}
+	}
+}
+
+// WithQueueSize sets the maximum number of events queued for the watcher
+func WithQueueSize(size int) WatchOption {
+	return func(options *watchOptions) {
+		options.queueSize = size
+	}
+}
+
+// WithOverflowPolicy sets what happens when the queue of the watcher is full; Block by default, so that no event
+// is lost and the queue is bounded. A watcher with the Block policy must not change the store while reading its
+// events, and a watcher with the Buffer policy needs a buffer limit.
+func WithOverflowPolicy(policy OverflowPolicy) WatchOption {
+	return func(options *watchOptions) {
+		options.policy = policy
+	}
+}
+
+// WithBufferLimit sets the maximum number of events queued for a watcher with the Buffer policy, beyond which
+// it is disconnected
+func WithBufferLimit(limit int) WatchOption {
+	return func(options *watchOptions) {
+		options.bufferLimit = limit
+	}
+}
+
+// filter returns the filter of the watched events, or nil if all of them are watched
+func (o *watchOptions) filter() func(Event) bool {
+	if o.eventTypes == nil && o.e2NodeIDs == nil {
+		return nil
+	}
+	return func(event Event) bool {
+		if o.eventTypes != nil {
+			eventType, ok := event.Type.(MetricEvent)
+			if !ok || !o.eventTypes[eventType] {
+				return false
+			}
+		}
+		return o.e2NodeIDs == nil || o.e2NodeIDs[event.Value.Value.E2NodeID]
+	}
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proposals
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	topoapi "github.com/onosproject/onos-api/go/onos/topo"
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// MaxHistory is the number of decided proposals kept in the history
+const MaxHistory = 1000
+
+// Status is the status of a proposal
+type Status int
+
+const (
+	// Pending proposals wait for the operator's decision
+	Pending Status = iota
+	// Approved proposals were applied
+	Approved
+	// Rejected proposals were rejected by the operator
+	Rejected
+	// Expired proposals were not decided in time
+	Expired
+	// Superseded proposals were replaced by a newer proposal for the same cell
+	Superseded
+)
+
+var statusNames = [...]string{"Pending", "Approved", "Rejected", "Expired", "Superseded"}
+
+func (s Status) String() string {
+	if s < 0 || int(s) >= len(statusNames) {
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+	return statusNames[s]
+}
+
+// Proposal is a PCI change computed by the controller in dry-run mode, which is applied only once approved
+type Proposal struct {
+	// CellID is the key of the cell in the metrics store
+	CellID   uint64
+	E2NodeID topoapi.ID
+	// CurrentPCI is the PCI of the cell when the change was proposed
+	CurrentPCI  int32
+	ProposedPCI int32
+	// Neighbors are the keys of the neighbors of the cell, which are affected by the change
+	Neighbors []uint64
+	// ConflictingCells are the keys of the cells the cell is in conflict with, if any
+	ConflictingCells []uint64
+	// Reason is why the controller proposed the change
+	Reason    string
+	CreatedAt time.Time
+
+	Status Status
+	// DecidedAt and Decision are the time and the reason of the decision; only set once the proposal is not pending
+	DecidedAt time.Time
+	Decision  string
+}
+
+// Store PCI change proposal store interface; a cell has at most one pending proposal
+type Store interface {
+	// Put puts a pending proposal, superseding the one of the same cell;
+	// a proposal of the same PCI change is kept as it is
+	Put(ctx context.Context, proposal Proposal) error
+
+	// Get gets the pending proposal of a cell
+	Get(ctx context.Context, cellID uint64) (*Proposal, error)
+
+	// List lists all pending proposals ordered by cell ID
+	List(ctx context.Context) ([]*Proposal, error)
+
+	// Decide closes the pending proposal of a cell with the given status and moves it to the history
+	Decide(ctx context.Context, cellID uint64, status Status, decision string) (*Proposal, error)
+
+	// Expire closes the pending proposals created before a given time as expired
+	Expire(ctx context.Context, before time.Time) ([]*Proposal, error)
+
+	// History lists the last MaxHistory decided proposals from the oldest to the newest decision
+	History(ctx context.Context) ([]*Proposal, error)
+}
+
+type store struct {
+	proposals map[uint64]Proposal
+	history   []Proposal
+	mu        sync.RWMutex
+}
+
+// NewStore creates new store
+func NewStore() Store {
+	return &store{
+		proposals: make(map[uint64]Proposal),
+		history:   make([]Proposal, 0),
+	}
+}
+
+func (s *store) Put(_ context.Context, proposal Proposal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if p, ok := s.proposals[proposal.CellID]; ok {
+		if p.CurrentPCI == proposal.CurrentPCI && p.ProposedPCI == proposal.ProposedPCI {
+			return nil
+		}
+		s.decide(p, Superseded, "superseded by a newer proposal")
+	}
+	if proposal.CreatedAt.IsZero() {
+		proposal.CreatedAt = time.Now()
+	}
+	proposal.Status = Pending
+	s.proposals[proposal.CellID] = proposal
+	return nil
+}
+
+func (s *store) Get(_ context.Context, cellID uint64) (*Proposal, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if p, ok := s.proposals[cellID]; ok {
+		return &p, nil
+	}
+	return nil, errors.NewNotFound("no PCI change is proposed for cell %v", cellID)
+}
+
+func (s *store) List(_ context.Context) ([]*Proposal, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	proposals := make([]*Proposal, 0, len(s.proposals))
+	for _, p := range s.proposals {
+		p := p
+		proposals = append(proposals, &p)
+	}
+	sort.Slice(proposals, func(i, j int) bool {
+		return proposals[i].CellID < proposals[j].CellID
+	})
+	return proposals, nil
+}
+
+func (s *store) Decide(_ context.Context, cellID uint64, status Status, decision string) (*Proposal, error) {
+	if status == Pending {
+		return nil, errors.NewInvalid("a proposal cannot be decided as pending")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.proposals[cellID]
+	if !ok {
+		return nil, errors.NewNotFound("no PCI change is proposed for cell %v", cellID)
+	}
+	p = s.decide(p, status, decision)
+	return &p, nil
+}
+
+func (s *store) Expire(_ context.Context, before time.Time) ([]*Proposal, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expired := make([]*Proposal, 0)
+	for _, p := range s.proposals {
+		if p.CreatedAt.Before(before) {
+			decided := s.decide(p, Expired, "not decided in time")
+			expired = append(expired, &decided)
+		}
+	}
+	return expired, nil
+}
+
+func (s *store) History(_ context.Context) ([]*Proposal, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	history := make([]*Proposal, 0, len(s.history))
+	for _, p := range s.history {
+		p := p
+		history = append(history, &p)
+	}
+	return history, nil
+}
+
+// decide removes a pending proposal and appends it to the history; the caller should hold the lock
+func (s *store) decide(p Proposal, status Status, decision string) Proposal {
+	delete(s.proposals, p.CellID)
+	p.Status = status
+	p.DecidedAt = time.Now()
+	p.Decision = decision
+	s.history = append(s.history, p)
+	if len(s.history) > MaxHistory {
+		s.history = s.history[len(s.history)-MaxHistory:]
+	}
+	return p
+}
+
+var _ Store = &store{}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package proposals
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	createdAt := time.Now().Add(-time.Minute)
+	assert.NoError(t, s.Put(ctx, Proposal{CellID: 2, CurrentPCI: 1, ProposedPCI: 3, CreatedAt: createdAt}))
+	assert.NoError(t, s.Put(ctx, Proposal{CellID: 1, CurrentPCI: 1, ProposedPCI: 4}))
+
+	// the same change is kept as it was proposed first
+	assert.NoError(t, s.Put(ctx, Proposal{CellID: 2, CurrentPCI: 1, ProposedPCI: 3}))
+	p, err := s.Get(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, createdAt, p.CreatedAt)
+
+	// a different change supersedes the proposal
+	assert.NoError(t, s.Put(ctx, Proposal{CellID: 2, CurrentPCI: 1, ProposedPCI: 5}))
+	p, err = s.Get(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), p.ProposedPCI)
+	assert.Equal(t, Pending, p.Status)
+	assert.NotEqual(t, createdAt, p.CreatedAt)
+
+	list, err := s.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, uint64(1), list[0].CellID)
+
+	p, err = s.Decide(ctx, 1, Rejected, "maintenance window")
+	assert.NoError(t, err)
+	assert.Equal(t, Rejected, p.Status)
+	_, err = s.Decide(ctx, 1, Approved, "")
+	assert.True(t, errors.IsNotFound(err))
+	_, err = s.Get(ctx, 1)
+	assert.True(t, errors.IsNotFound(err))
+
+	history, err := s.History(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, history, 2)
+	assert.Equal(t, Superseded, history[0].Status)
+	assert.Equal(t, int32(3), history[0].ProposedPCI)
+	assert.Equal(t, Rejected, history[1].Status)
+	assert.Equal(t, "maintenance window", history[1].Decision)
+}
+
+func TestExpire(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	now := time.Now()
+	assert.NoError(t, s.Put(ctx, Proposal{CellID: 1, ProposedPCI: 3, CreatedAt: now.Add(-time.Hour)}))
+	assert.NoError(t, s.Put(ctx, Proposal{CellID: 2, ProposedPCI: 4, CreatedAt: now}))
+
+	expired, err := s.Expire(ctx, now.Add(-time.Minute))
+	assert.NoError(t, err)
+	assert.Len(t, expired, 1)
+	assert.Equal(t, uint64(1), expired[0].CellID)
+	assert.Equal(t, Expired, expired[0].Status)
+	assert.Equal(t, "Expired", expired[0].Status.String())
+	assert.Equal(t, "Status(7)", Status(7).String())
+
+	list, err := s.List(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, uint64(2), list[0].CellID)
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reserved
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+	"github.com/onosproject/onos-pci/pkg/types"
+)
+
+// Scope is the scope of a reserved PCI list
+type Scope int
+
+const (
+	// Global reserved PCIs are excluded for all cells
+	Global Scope = iota
+	// PLMN reserved PCIs are excluded for the cells of a PLMN
+	PLMN
+	// Cluster reserved PCIs are excluded for the cells of a geographic cluster
+	Cluster
+)
+
+func (s Scope) String() string {
+	return [...]string{"Global", "PLMN", "Cluster"}[s]
+}
+
+// Key is the key of a reserved PCI list: PlmnID is only set for PLMN scope and Cluster only for Cluster scope
+type Key struct {
+	Scope   Scope
+	PlmnID  uint32
+	Cluster string
+}
+
+// Entry is a reserved PCI list
+type Entry struct {
+	Key  Key
+	PCIs []int32
+}
+
+// Store reserved PCI store interface
+type Store interface {
+	// Set sets the reserved PCI list of a key; an empty list removes the key
+	Set(ctx context.Context, key Key, pcis []int32) error
+
+	// Get gets the reserved PCI list of a key
+	Get(ctx context.Context, key Key) (*Entry, error)
+
+	// List lists all reserved PCI lists
+	List(ctx context.Context) ([]*Entry, error)
+
+	// GetReservedPcis returns the PCIs reserved for a cell of a given PLMN and cluster in any scope
+	GetReservedPcis(ctx context.Context, plmnID uint32, cluster string) map[int32]bool
+}
+
+type store struct {
+	reserved map[Key][]int32
+	mu       sync.RWMutex
+}
+
+// NewStore creates new store
+func NewStore() Store {
+	return &store{
+		reserved: make(map[Key][]int32),
+	}
+}
+
+// NewStoreWithConfig creates new store with the reserved PCIs in the app config
+func NewStoreWithConfig(config types.ReservedPCIConfig) (Store, error) {
+	s := NewStore()
+	ctx := context.Background()
+	if err := s.Set(ctx, Key{Scope: Global}, config.Global); err != nil {
+		return nil, err
+	}
+	for plmnID, pcis := range config.PLMNs {
+		if err := s.Set(ctx, Key{Scope: PLMN, PlmnID: plmnID}, pcis); err != nil {
+			return nil, err
+		}
+	}
+	for cluster, pcis := range config.Clusters {
+		if err := s.Set(ctx, Key{Scope: Cluster, Cluster: cluster}, pcis); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *store) Set(_ context.Context, key Key, pcis []int32) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	sorted := make([]int32, 0, len(pcis))
+	added := make(map[int32]bool)
+	for _, pci := range pcis {
+		if pci < types.LowerNRPCI || pci > types.UpperNRPCI {
+			return errors.NewInvalid("reserved PCI %v is out of %v..%v", pci, types.LowerNRPCI, types.UpperNRPCI)
+		}
+		if added[pci] {
+			continue
+		}
+		added[pci] = true
+		sorted = append(sorted, pci)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(sorted) == 0 {
+		delete(s.reserved, key)
+		return nil
+	}
+	s.reserved[key] = sorted
+	return nil
+}
+
+func (s *store) Get(_ context.Context, key Key) (*Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if pcis, ok := s.reserved[key]; ok {
+		return &Entry{
+			Key:  key,
+			PCIs: append([]int32{}, pcis...),
+		}, nil
+	}
+	return nil, errors.New(errors.NotFound, "the reserved PCI list does not exist")
+}
+
+func (s *store) List(_ context.Context) ([]*Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	entries := make([]*Entry, 0, len(s.reserved))
+	for key, pcis := range s.reserved {
+		entries = append(entries, &Entry{
+			Key:  key,
+			PCIs: append([]int32{}, pcis...),
+		})
+	}
+	return entries, nil
+}
+
+func (s *store) GetReservedPcis(_ context.Context, plmnID uint32, cluster string) map[int32]bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	reserved := make(map[int32]bool)
+	keys := []Key{{Scope: Global}, {Scope: PLMN, PlmnID: plmnID}}
+	if cluster != "" {
+		keys = append(keys, Key{Scope: Cluster, Cluster: cluster})
+	}
+	for _, key := range keys {
+		for _, pci := range s.reserved[key] {
+			reserved[pci] = true
+		}
+	}
+	return reserved
+}
+
+func validateKey(key Key) error {
+	switch key.Scope {
+	case Global:
+		if key.PlmnID != 0 || key.Cluster != "" {
+			return errors.NewInvalid("global reserved PCIs should not have PLMN ID or cluster")
+		}
+	case PLMN:
+		if key.Cluster != "" {
+			return errors.NewInvalid("PLMN reserved PCIs should not have cluster")
+		}
+	case Cluster:
+		if key.Cluster == "" || key.PlmnID != 0 {
+			return errors.NewInvalid("cluster reserved PCIs should have cluster only")
+		}
+	default:
+		return errors.NewInvalid("unknown reserved PCI scope %v", key.Scope)
+	}
+	return nil
+}
+
+var _ Store = &store{}
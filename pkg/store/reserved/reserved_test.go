@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reserved
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onosproject/onos-pci/pkg/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReservedPcis(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewStoreWithConfig(types.ReservedPCIConfig{
+		Global:   []int32{1, 2},
+		PLMNs:    map[uint32][]int32{0x138426: {3}},
+		Clusters: map[string][]int32{"downtown": {4, 4, 5}},
+	})
+	assert.NoError(t, err)
+
+	assert.Equal(t, map[int32]bool{1: true, 2: true}, s.GetReservedPcis(ctx, 0x1, ""))
+	assert.Equal(t, map[int32]bool{1: true, 2: true, 3: true}, s.GetReservedPcis(ctx, 0x138426, "uptown"))
+	assert.Equal(t, map[int32]bool{1: true, 2: true, 3: true, 4: true, 5: true}, s.GetReservedPcis(ctx, 0x138426, "downtown"))
+
+	entry, err := s.Get(ctx, Key{Scope: Cluster, Cluster: "downtown"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{4, 5}, entry.PCIs)
+
+	// an empty list removes the reserved PCIs
+	assert.NoError(t, s.Set(ctx, Key{Scope: Global}, nil))
+	assert.Equal(t, map[int32]bool{}, s.GetReservedPcis(ctx, 0x1, ""))
+	_, err = s.Get(ctx, Key{Scope: Global})
+	assert.Error(t, err)
+}
+
+func TestSetInvalid(t *testing.T) {
+	ctx := context.Background()
+	s := NewStore()
+	assert.Error(t, s.Set(ctx, Key{Scope: Global}, []int32{1008}))
+	assert.Error(t, s.Set(ctx, Key{Scope: Global, Cluster: "downtown"}, []int32{1}))
+	assert.Error(t, s.Set(ctx, Key{Scope: Cluster}, []int32{1}))
+	entries, err := s.List(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
@@ -6,13 +6,20 @@
 package types
 
 import (
+	"time"
+
 	topoapi "github.com/onosproject/onos-api/go/onos/topo"
 	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
 )
 
 const (
-	LowerPCI = 1
-	UpperPCI = 503
+	// LowerNRPCI and UpperNRPCI are the bounds of the NR PCI range
+	LowerNRPCI = 0
+	UpperNRPCI = 1007
+
+	// LowerEUTRAPCI and UpperEUTRAPCI are the bounds of the EUTRA PCI range
+	LowerEUTRAPCI = 0
+	UpperEUTRAPCI = 503
 )
 
 // PCIPool is the PCI pool to be able to assign a PCI to a cell
@@ -21,18 +28,103 @@ type PCIPool struct {
 	UpperPci int32
 }
 
+// PCIPoolConfig is the PCI pool configuration: the pools per cell, per E2 node, per ARFCN and by default per RAT
+type PCIPoolConfig struct {
+	NR     []*PCIPool
+	EUTRA  []*PCIPool
+	Nodes  map[topoapi.ID][]*PCIPool
+	Cells  map[uint64][]*PCIPool
+	ARFCNs map[int32][]*PCIPool
+	// RNIB enables the PCI pools set in R-NIB as an aspect of the E2 cells
+	RNIB bool
+}
+
+// ReservedPCIConfig is the configuration of the PCIs reserved for future rollout or for border coordination,
+// which are never assigned: globally, per PLMN ID and per geographic cluster
+type ReservedPCIConfig struct {
+	Global   []int32
+	PLMNs    map[uint32][]int32
+	Clusters map[string][]int32
+}
+
+// ModRule is a PCI modulo rule: co-channel neighbors whose PCIs are equal modulo Modulus interfere with each other,
+// e.g., PCI mod 3 for PSS/SSS and PCI mod 30 for DMRS/SRS; Weight is the penalty for each neighbor clashing
+type ModRule struct {
+	Modulus int32
+	Weight  int32
+}
+
+// Bandwidths are the channel bandwidths in kHz of the carriers of a RAT: ARFCNs for the carriers with a configured
+// bandwidth and Default for the others; carriers with zero bandwidth only overlap with the same ARFCN
+type Bandwidths struct {
+	Default uint32
+	ARFCNs  map[int32]uint32
+}
+
 // CellMetric is the metric struct which has EARFCN-DL, size, and PCI of a cell
 type CellMetric struct {
 	ARFCN             int32
 	PCI               int32
 	PreviousPCI       int32
 	ResolvedConflicts uint32
+	// ChangeID identifies the last PCI change of the cell; the control result of the change carries it
+	ChangeID uint64
+}
+
+// ControlFailure is the class of failure of an RC control message
+type ControlFailure int
+
+const (
+	// NoFailure control messages succeeded
+	NoFailure ControlFailure = iota
+	// BuildFailure control messages could not be encoded
+	BuildFailure
+	// E2TFailure control messages could not be delivered through E2T
+	E2TFailure
+	// RICControlFailure control messages were rejected by the E2 node with a cause
+	RICControlFailure
+)
+
+func (f ControlFailure) String() string {
+	return [...]string{"NoFailure", "BuildFailure", "E2TFailure", "RICControlFailure"}[f]
+}
+
+// ControlResult is the outcome of the RC control message that sets the PCI of a cell
+type ControlResult struct {
+	PCI int32
+	// ChangeID identifies the PCI change the control message was sent for
+	ChangeID uint64
+	// Outcome is the RIC control outcome payload returned by the E2 node, if any
+	Outcome []byte
+	// Error is the reason why the control message failed; empty if it succeeded
+	Error string
+	// Failure is the class of the last failure; Cause is the E2AP cause of a RICControlFailure
+	Failure ControlFailure
+	Cause   string
+	// Attempts is the number of control messages sent, including the retries
+	Attempts int
+	// Acked is when the E2 node acknowledged the control message; zero if it failed
+	Acked time.Time
+	// RolledBack is whether the PCI of the cell was set back to its previous PCI after the final failure
+	RolledBack bool
 }
 
 // CellPCI is the PCI-NRT information
 type CellPCI struct {
-	E2NodeID    topoapi.ID
+	E2NodeID topoapi.ID
+	// Cluster is the geographic cluster of the cell, if any
+	Cluster string
+	// Locked cells keep their PCI; the controller never changes it
+	Locked      bool
 	Metric      *CellMetric
 	PCIPoolList []*PCIPool
 	Neighbors   []*e2smrc.NeighborCellItem
+	// LastControl is the outcome of the last RC control message sent for the cell
+	LastControl *ControlResult
+	// LastUpdated is when the last indication message of the cell arrived
+	LastUpdated time.Time
+	// Stale cells did not send an indication message within the TTL
+	Stale bool
+	// Reloaded cells were loaded from the persistent metrics store and did not send an indication message since
+	Reloaded bool
 }
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package arfcn
+
+import (
+	"github.com/onosproject/onos-lib-go/pkg/errors"
+)
+
+// NR global frequency raster in 3GPP TS 38.104 Table 5.4.2.1-1
+const (
+	maxNRARFCN = 3279165
+	nrRange2   = 600000
+	nrRange3   = 2016667
+)
+
+// eutraBand is a EUTRA operating band in 3GPP TS 36.101 Table 5.7.3-1:
+// the downlink EARFCNs from offset to max are mapped on a 100 kHz raster starting from lowFreq
+type eutraBand struct {
+	band    int
+	lowFreq int64
+	offset  int32
+	max     int32
+}
+
+var eutraBands = []eutraBand{
+	{band: 1, lowFreq: 2110000, offset: 0, max: 599},
+	{band: 2, lowFreq: 1930000, offset: 600, max: 1199},
+	{band: 3, lowFreq: 1805000, offset: 1200, max: 1949},
+	{band: 4, lowFreq: 2110000, offset: 1950, max: 2399},
+	{band: 5, lowFreq: 869000, offset: 2400, max: 2649},
+	{band: 7, lowFreq: 2620000, offset: 2750, max: 3449},
+	{band: 8, lowFreq: 925000, offset: 3450, max: 3799},
+	{band: 12, lowFreq: 729000, offset: 5010, max: 5179},
+	{band: 13, lowFreq: 746000, offset: 5180, max: 5279},
+	{band: 14, lowFreq: 758000, offset: 5280, max: 5379},
+	{band: 17, lowFreq: 734000, offset: 5730, max: 5849},
+	{band: 20, lowFreq: 791000, offset: 6150, max: 6449},
+	{band: 25, lowFreq: 1930000, offset: 8040, max: 8689},
+	{band: 26, lowFreq: 859000, offset: 8690, max: 9039},
+	{band: 28, lowFreq: 758000, offset: 9210, max: 9659},
+	{band: 38, lowFreq: 2570000, offset: 37750, max: 38249},
+	{band: 40, lowFreq: 2300000, offset: 38650, max: 39649},
+	{band: 41, lowFreq: 2496000, offset: 39650, max: 41589},
+	{band: 42, lowFreq: 3400000, offset: 41590, max: 43589},
+	{band: 43, lowFreq: 3600000, offset: 43590, max: 45589},
+	{band: 48, lowFreq: 3550000, offset: 55240, max: 56739},
+	{band: 66, lowFreq: 2110000, offset: 66436, max: 67335},
+	{band: 71, lowFreq: 617000, offset: 68586, max: 68935},
+}
+
+// NRFrequency returns the frequency in kHz of an NR-ARFCN
+func NRFrequency(arfcn int32) (int64, error) {
+	n := int64(arfcn)
+	switch {
+	case arfcn < 0 || arfcn > maxNRARFCN:
+		return 0, errors.NewInvalid("NR-ARFCN %d is out of range", arfcn)
+	case arfcn < nrRange2:
+		return 5 * n, nil
+	case arfcn < nrRange3:
+		return 3000000 + 15*(n-nrRange2), nil
+	default:
+		return 24250080 + 60*(n-nrRange3), nil
+	}
+}
+
+// EUTRAFrequency returns the downlink frequency in kHz of a EARFCN
+func EUTRAFrequency(earfcn int32) (int64, error) {
+	for _, b := range eutraBands {
+		if earfcn >= b.offset && earfcn <= b.max {
+			return b.lowFreq + 100*int64(earfcn-b.offset), nil
+		}
+	}
+	return 0, errors.NewNotSupported("EARFCN %d is not in a supported downlink band", earfcn)
+}
+
+// Overlaps checks if two channels overlap, given their center frequencies and bandwidths in kHz
+func Overlaps(freqA int64, bandwidthA uint32, freqB int64, bandwidthB uint32) bool {
+	if freqA == freqB {
+		return true
+	}
+	distance := freqA - freqB
+	if distance < 0 {
+		distance = -distance
+	}
+	return 2*distance < int64(bandwidthA)+int64(bandwidthB)
+}
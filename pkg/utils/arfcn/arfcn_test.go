@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package arfcn
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNRFrequency(t *testing.T) {
+	freq, err := NRFrequency(100000)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(500000), freq)
+
+	freq, err = NRFrequency(632628)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3489420), freq)
+
+	freq, err = NRFrequency(2016667)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(24250080), freq)
+
+	_, err = NRFrequency(3279166)
+	assert.Error(t, err)
+}
+
+func TestEUTRAFrequency(t *testing.T) {
+	freq, err := EUTRAFrequency(1575)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1842500), freq)
+
+	freq, err = EUTRAFrequency(0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2110000), freq)
+
+	_, err = EUTRAFrequency(18000)
+	assert.Error(t, err)
+}
+
+func TestOverlaps(t *testing.T) {
+	assert.True(t, Overlaps(3489420, 0, 3489420, 0))
+	assert.False(t, Overlaps(3489420, 0, 3489450, 0))
+	// 100 MHz and 20 MHz channels 50 MHz apart partially overlap
+	assert.True(t, Overlaps(3500000, 100000, 3550000, 20000))
+	// adjacent channels do not overlap
+	assert.False(t, Overlaps(3500000, 20000, 3520000, 20000))
+}
@@ -7,6 +7,7 @@ package parse
 
 import (
 	e2smrccomm "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-common-ies"
+	e2smrc "github.com/onosproject/onos-e2-sm/servicemodels/e2sm_rc/v1/e2sm-rc-ies"
 	"github.com/onosproject/onos-lib-go/pkg/errors"
 )
 
@@ -52,6 +53,42 @@ func GetCellID(cellGlobalID *e2smrccomm.Cgi) (uint64, error) {
 	return 0, errors.New(errors.NotSupported, "CGI should be one of NrCGI and ECGI")
 }
 
+// GetNeighborInfo returns the CGI, PCI and ARFCN of a neighbor cell item for both NR and EUTRA neighbors
+func GetNeighborInfo(n *e2smrc.NeighborCellItem) (*e2smrccomm.Cgi, int32, int32, error) {
+	if n.GetRanTypeChoiceNr() != nil {
+		cgi := &e2smrccomm.Cgi{
+			Cgi: &e2smrccomm.Cgi_NRCgi{
+				NRCgi: n.GetRanTypeChoiceNr().GetNRCgi(),
+			},
+		}
+		return cgi,
+			n.GetRanTypeChoiceNr().GetNRPci().GetValue(),
+			n.GetRanTypeChoiceNr().GetNRFreqInfo().GetNrArfcn().GetNRarfcn(),
+			nil
+	} else if n.GetRanTypeChoiceEutra() != nil {
+		cgi := &e2smrccomm.Cgi{
+			Cgi: &e2smrccomm.Cgi_EUtraCgi{
+				EUtraCgi: n.GetRanTypeChoiceEutra().GetEUtraCgi(),
+			},
+		}
+		return cgi,
+			n.GetRanTypeChoiceEutra().GetEUtraPci().GetValue(),
+			n.GetRanTypeChoiceEutra().GetEUtraArfcn().GetValue(),
+			nil
+	}
+	return nil, 0, 0, errors.New(errors.NotSupported, "neighbor type should be NR or EUTRA")
+}
+
+// GetCGIType returns the CGI type of a CGI
+func GetCGIType(cgi *e2smrccomm.Cgi) CGIType {
+	if cgi.GetNRCgi() != nil {
+		return CGITypeNrCGI
+	} else if cgi.GetEUtraCgi() != nil {
+		return CGITypeECGI
+	}
+	return CGITypeUnknown
+}
+
 func BitStringToUint64(bitString []byte, bitCount int) uint64 {
 	var result uint64
 	for i, b := range bitString {
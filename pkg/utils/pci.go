@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2022-present Intel Corporation
+// SPDX-FileCopyrightText: 2020-present Open Networking Foundation <info@opennetworking.org>
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+const (
+	// NRModRulesConfigPath NR PCI modulo rules config path
+	NRModRulesConfigPath = "/pci/mod_rules/nr"
+	// EUTRAModRulesConfigPath EUTRA PCI modulo rules config path
+	EUTRAModRulesConfigPath = "/pci/mod_rules/eutra"
+	// SearchDepthConfigPath PCI neighbor traversal depth config path
+	SearchDepthConfigPath = "/pci/search_depth"
+	// NRBandwidthsConfigPath NR channel bandwidths config path
+	NRBandwidthsConfigPath = "/pci/bandwidth/nr"
+	// EUTRABandwidthsConfigPath EUTRA channel bandwidths config path
+	EUTRABandwidthsConfigPath = "/pci/bandwidth/eutra"
+	// PCIPoolsConfigPath PCI pools config path
+	PCIPoolsConfigPath = "/pci/pools"
+	// ReservedPCIsConfigPath reserved PCIs config path
+	ReservedPCIsConfigPath = "/pci/reserved"
+	// ClustersConfigPath geographic clusters config path
+	ClustersConfigPath = "/pci/clusters"
+	// ModeConfigPath PCI controller mode config path
+	ModeConfigPath = "/pci/mode"
+	// OptimizationIntervalConfigPath PCI optimization interval config path
+	OptimizationIntervalConfigPath = "/pci/optimizer/interval"
+	// DryRunConfigPath PCI controller dry-run mode config path
+	DryRunConfigPath = "/pci/dry_run"
+	// ProposalExpiryConfigPath PCI change proposal expiry config path
+	ProposalExpiryConfigPath = "/pci/proposals/expiry"
+	// HistoryLimitConfigPath PCI change history limit per cell config path
+	HistoryLimitConfigPath = "/pci/history/limit"
+	// StaleTTLConfigPath stale cell TTL config path
+	StaleTTLConfigPath = "/pci/stale/ttl"
+	// StaleEvictConfigPath stale cell eviction config path
+	StaleEvictConfigPath = "/pci/stale/evict"
+	// StaleOccupyConfigPath stale cell PCI occupation config path
+	StaleOccupyConfigPath = "/pci/stale/occupy"
+	// ControlRetriesConfigPath RC control message retries config path
+	ControlRetriesConfigPath = "/pci/control/retries"
+	// ControlBackoffConfigPath RC control message first retry backoff config path
+	ControlBackoffConfigPath = "/pci/control/backoff_ms"
+	// VerificationTimeoutConfigPath PCI change verification timeout config path
+	VerificationTimeoutConfigPath = "/pci/verification/timeout"
+	// CellSettleConfigPath cell settle window config path
+	CellSettleConfigPath = "/pci/settle/cell"
+	// ClusterSettleConfigPath cluster settle window config path
+	ClusterSettleConfigPath = "/pci/settle/cluster"
+	// OscillationChangesConfigPath number of PCI changes of a cell freezing it config path
+	OscillationChangesConfigPath = "/pci/oscillation/changes"
+	// OscillationWindowConfigPath oscillation detection window config path
+	OscillationWindowConfigPath = "/pci/oscillation/window"
+	// WorkersConfigPath number of conflict resolution workers config path
+	WorkersConfigPath = "/pci/workers"
+)
@@ -94,9 +94,11 @@ func WaitForNoConflicts(t *testing.T, mgr *manager.Manager) error {
 	store := mgr.GetMetricsStore()
 	server := northbound.NewTestServer(store)
 
-	// Wait for changes in the metrics store...
+	// Check the current cells, then wait for changes in the metrics store; the watch is cancelled on return
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	ch := make(chan metrics.Event)
-	err := store.Watch(context.Background(), ch)
+	err := store.Watch(ctx, ch, metrics.WithReplay())
 	assert.NoError(t, err)
 
 	// After each event, check for number of remaining conflicts
@@ -104,7 +106,7 @@ func WaitForNoConflicts(t *testing.T, mgr *manager.Manager) error {
 		pciEntry := e.Value.Value
 		t.Logf("Call %v has PCI %d", e.Key, pciEntry.Metric.PCI)
 
-		resp, err := server.GetConflicts(context.Background(), &pci.GetConflictsRequest{})
+		resp, err := server.GetConflicts(ctx, &pci.GetConflictsRequest{})
 		assert.NoError(t, err)
 		if len(resp.Cells) == 0 {
 			t.Log("All PCI conflicts eliminated")